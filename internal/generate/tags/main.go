@@ -1000,6 +1000,7 @@ func init() {
 	awsServiceNames["iotwireless"] = "IoTWireless"
 	awsServiceNames["ivs"] = "IVS"
 	awsServiceNames["kafka"] = "Kafka"
+	awsServiceNames["kafkaconnect"] = "KafkaConnect"
 	awsServiceNames["kendra"] = "Kendra"
 	awsServiceNames["kinesis"] = "Kinesis"
 	awsServiceNames["kinesisanalytics"] = "KinesisAnalytics"
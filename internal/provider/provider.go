@@ -88,6 +88,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/inspector"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iot"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesis"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalytics"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalyticsv2"
@@ -397,6 +398,8 @@ func Provider() *schema.Provider {
 			"aws_codecommit_approval_rule_template": codecommit.DataSourceApprovalRuleTemplate(),
 			"aws_codecommit_repository":             codecommit.DataSourceRepository(),
 
+			"aws_codepipeline": codepipeline.DataSourceCodePipeline(),
+
 			"aws_codestarconnections_connection": codestarconnections.DataSourceConnection(),
 
 			"aws_cognito_user_pools": cognitoidp.DataSourceUserPools(),
@@ -502,9 +505,11 @@ func Provider() *schema.Provider {
 			"aws_eks_node_group":   eks.DataSourceNodeGroup(),
 			"aws_eks_node_groups":  eks.DataSourceNodeGroups(),
 
-			"aws_elasticache_cluster":           elasticache.DataSourceCluster(),
-			"aws_elasticache_replication_group": elasticache.DataSourceReplicationGroup(),
-			"aws_elasticache_user":              elasticache.DataSourceUser(),
+			"aws_elasticache_cluster":                      elasticache.DataSourceCluster(),
+			"aws_elasticache_replication_group":            elasticache.DataSourceReplicationGroup(),
+			"aws_elasticache_reserved_cache_node_offering": elasticache.DataSourceReservedCacheNodeOffering(),
+			"aws_elasticache_subnet_group":                 elasticache.DataSourceSubnetGroup(),
+			"aws_elasticache_user":                         elasticache.DataSourceUser(),
 
 			"aws_elastic_beanstalk_application":    elasticbeanstalk.DataSourceApplication(),
 			"aws_elastic_beanstalk_hosted_zone":    elasticbeanstalk.DataSourceHostedZone(),
@@ -586,6 +591,7 @@ func Provider() *schema.Provider {
 			"aws_lambda_alias":               lambda.DataSourceAlias(),
 			"aws_lambda_code_signing_config": lambda.DataSourceCodeSigningConfig(),
 			"aws_lambda_function":            lambda.DataSourceFunction(),
+			"aws_lambda_function_url":        lambda.DataSourceFunctionURL(),
 			"aws_lambda_invocation":          lambda.DataSourceInvocation(),
 			"aws_lambda_layer_version":       lambda.DataSourceLayerVersion(),
 
@@ -831,9 +837,11 @@ func Provider() *schema.Provider {
 
 			"aws_autoscalingplans_scaling_plan": autoscalingplans.ResourceScalingPlan(),
 
+			"aws_backup_framework":                backup.ResourceFramework(),
 			"aws_backup_global_settings":          backup.ResourceGlobalSettings(),
 			"aws_backup_plan":                     backup.ResourcePlan(),
 			"aws_backup_region_settings":          backup.ResourceRegionSettings(),
+			"aws_backup_report_plan":              backup.ResourceReportPlan(),
 			"aws_backup_selection":                backup.ResourceSelection(),
 			"aws_backup_vault":                    backup.ResourceVault(),
 			"aws_backup_vault_lock_configuration": backup.ResourceVaultLockConfiguration(),
@@ -882,10 +890,12 @@ func Provider() *schema.Provider {
 
 			"aws_cloudtrail": cloudtrail.ResourceCloudTrail(),
 
-			"aws_cloudwatch_composite_alarm": cloudwatch.ResourceCompositeAlarm(),
-			"aws_cloudwatch_dashboard":       cloudwatch.ResourceDashboard(),
-			"aws_cloudwatch_metric_alarm":    cloudwatch.ResourceMetricAlarm(),
-			"aws_cloudwatch_metric_stream":   cloudwatch.ResourceMetricStream(),
+			"aws_cloudwatch_anomaly_detector": cloudwatch.ResourceAnomalyDetector(),
+			"aws_cloudwatch_composite_alarm":  cloudwatch.ResourceCompositeAlarm(),
+			"aws_cloudwatch_dashboard":        cloudwatch.ResourceDashboard(),
+			"aws_cloudwatch_insight_rule":     cloudwatch.ResourceInsightRule(),
+			"aws_cloudwatch_metric_alarm":     cloudwatch.ResourceMetricAlarm(),
+			"aws_cloudwatch_metric_stream":    cloudwatch.ResourceMetricStream(),
 
 			"aws_cloudwatch_event_api_destination": events.ResourceAPIDestination(),
 			"aws_cloudwatch_event_archive":         events.ResourceArchive(),
@@ -893,6 +903,7 @@ func Provider() *schema.Provider {
 			"aws_cloudwatch_event_bus_policy":      events.ResourceBusPolicy(),
 			"aws_cloudwatch_event_connection":      events.ResourceConnection(),
 			"aws_cloudwatch_event_permission":      events.ResourcePermission(),
+			"aws_cloudwatch_event_replay":          events.ResourceReplay(),
 			"aws_cloudwatch_event_rule":            events.ResourceRule(),
 			"aws_cloudwatch_event_target":          events.ResourceTarget(),
 
@@ -912,6 +923,7 @@ func Provider() *schema.Provider {
 
 			"aws_codebuild_project":           codebuild.ResourceProject(),
 			"aws_codebuild_report_group":      codebuild.ResourceReportGroup(),
+			"aws_codebuild_resource_policy":   codebuild.ResourceResourcePolicy(),
 			"aws_codebuild_source_credential": codebuild.ResourceSourceCredential(),
 			"aws_codebuild_webhook":           codebuild.ResourceWebhook(),
 
@@ -1020,106 +1032,115 @@ func Provider() *schema.Provider {
 			"aws_dynamodb_global_table":                  dynamodb.ResourceGlobalTable(),
 			"aws_dynamodb_kinesis_streaming_destination": dynamodb.ResourceKinesisStreamingDestination(),
 			"aws_dynamodb_table":                         dynamodb.ResourceTable(),
+			"aws_dynamodb_table_export":                  dynamodb.ResourceTableExport(),
 			"aws_dynamodb_table_item":                    dynamodb.ResourceTableItem(),
+			"aws_dynamodb_table_items":                   dynamodb.ResourceTableItems(),
 			"aws_dynamodb_tag":                           dynamodb.ResourceTag(),
 
-			"aws_ami":                                             ec2.ResourceAMI(),
-			"aws_ami_copy":                                        ec2.ResourceAMICopy(),
-			"aws_ami_from_instance":                               ec2.ResourceAMIFromInstance(),
-			"aws_ami_launch_permission":                           ec2.ResourceAMILaunchPermission(),
-			"aws_customer_gateway":                                ec2.ResourceCustomerGateway(),
-			"aws_default_network_acl":                             ec2.ResourceDefaultNetworkACL(),
-			"aws_default_route_table":                             ec2.ResourceDefaultRouteTable(),
-			"aws_default_security_group":                          ec2.ResourceDefaultSecurityGroup(),
-			"aws_default_subnet":                                  ec2.ResourceDefaultSubnet(),
-			"aws_default_vpc":                                     ec2.ResourceDefaultVPC(),
-			"aws_default_vpc_dhcp_options":                        ec2.ResourceDefaultVPCDHCPOptions(),
-			"aws_ebs_default_kms_key":                             ec2.ResourceEBSDefaultKMSKey(),
-			"aws_ebs_encryption_by_default":                       ec2.ResourceEBSEncryptionByDefault(),
-			"aws_ebs_snapshot":                                    ec2.ResourceEBSSnapshot(),
-			"aws_ebs_snapshot_copy":                               ec2.ResourceEBSSnapshotCopy(),
-			"aws_ebs_snapshot_import":                             ec2.ResourceEBSSnapshotImport(),
-			"aws_ebs_volume":                                      ec2.ResourceEBSVolume(),
-			"aws_ec2_availability_zone_group":                     ec2.ResourceAvailabilityZoneGroup(),
-			"aws_ec2_capacity_reservation":                        ec2.ResourceCapacityReservation(),
-			"aws_ec2_carrier_gateway":                             ec2.ResourceCarrierGateway(),
-			"aws_ec2_client_vpn_authorization_rule":               ec2.ResourceClientVPNAuthorizationRule(),
-			"aws_ec2_client_vpn_endpoint":                         ec2.ResourceClientVPNEndpoint(),
-			"aws_ec2_client_vpn_network_association":              ec2.ResourceClientVPNNetworkAssociation(),
-			"aws_ec2_client_vpn_route":                            ec2.ResourceClientVPNRoute(),
-			"aws_ec2_fleet":                                       ec2.ResourceFleet(),
-			"aws_ec2_host":                                        ec2.ResourceHost(),
-			"aws_ec2_local_gateway_route":                         ec2.ResourceLocalGatewayRoute(),
-			"aws_ec2_local_gateway_route_table_vpc_association":   ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
-			"aws_ec2_managed_prefix_list":                         ec2.ResourceManagedPrefixList(),
-			"aws_ec2_managed_prefix_list_entry":                   ec2.ResourceManagedPrefixListEntry(),
-			"aws_ec2_subnet_cidr_reservation":                     ec2.ResourceSubnetCIDRReservation(),
-			"aws_ec2_tag":                                         ec2.ResourceTag(),
-			"aws_ec2_traffic_mirror_filter":                       ec2.ResourceTrafficMirrorFilter(),
-			"aws_ec2_traffic_mirror_filter_rule":                  ec2.ResourceTrafficMirrorFilterRule(),
-			"aws_ec2_traffic_mirror_session":                      ec2.ResourceTrafficMirrorSession(),
-			"aws_ec2_traffic_mirror_target":                       ec2.ResourceTrafficMirrorTarget(),
-			"aws_ec2_transit_gateway":                             ec2.ResourceTransitGateway(),
-			"aws_ec2_transit_gateway_peering_attachment":          ec2.ResourceTransitGatewayPeeringAttachment(),
-			"aws_ec2_transit_gateway_peering_attachment_accepter": ec2.ResourceTransitGatewayPeeringAttachmentAccepter(),
-			"aws_ec2_transit_gateway_prefix_list_reference":       ec2.ResourceTransitGatewayPrefixListReference(),
-			"aws_ec2_transit_gateway_route":                       ec2.ResourceTransitGatewayRoute(),
-			"aws_ec2_transit_gateway_route_table":                 ec2.ResourceTransitGatewayRouteTable(),
-			"aws_ec2_transit_gateway_route_table_association":     ec2.ResourceTransitGatewayRouteTableAssociation(),
-			"aws_ec2_transit_gateway_route_table_propagation":     ec2.ResourceTransitGatewayRouteTablePropagation(),
-			"aws_ec2_transit_gateway_vpc_attachment":              ec2.ResourceTransitGatewayVPCAttachment(),
-			"aws_ec2_transit_gateway_vpc_attachment_accepter":     ec2.ResourceTransitGatewayVPCAttachmentAccepter(),
-			"aws_egress_only_internet_gateway":                    ec2.ResourceEgressOnlyInternetGateway(),
-			"aws_eip":                                             ec2.ResourceEIP(),
-			"aws_eip_association":                                 ec2.ResourceEIPAssociation(),
-			"aws_flow_log":                                        ec2.ResourceFlowLog(),
-			"aws_instance":                                        ec2.ResourceInstance(),
-			"aws_internet_gateway":                                ec2.ResourceInternetGateway(),
-			"aws_key_pair":                                        ec2.ResourceKeyPair(),
-			"aws_launch_template":                                 ec2.ResourceLaunchTemplate(),
-			"aws_main_route_table_association":                    ec2.ResourceMainRouteTableAssociation(),
-			"aws_nat_gateway":                                     ec2.ResourceNatGateway(),
-			"aws_network_acl":                                     ec2.ResourceNetworkACL(),
-			"aws_network_acl_rule":                                ec2.ResourceNetworkACLRule(),
-			"aws_network_interface":                               ec2.ResourceNetworkInterface(),
-			"aws_network_interface_attachment":                    ec2.ResourceNetworkInterfaceAttachment(),
-			"aws_network_interface_sg_attachment":                 ec2.ResourceNetworkInterfaceSGAttachment(),
-			"aws_placement_group":                                 ec2.ResourcePlacementGroup(),
-			"aws_route":                                           ec2.ResourceRoute(),
-			"aws_route_table":                                     ec2.ResourceRouteTable(),
-			"aws_route_table_association":                         ec2.ResourceRouteTableAssociation(),
-			"aws_security_group":                                  ec2.ResourceSecurityGroup(),
-			"aws_security_group_rule":                             ec2.ResourceSecurityGroupRule(),
-			"aws_snapshot_create_volume_permission":               ec2.ResourceSnapshotCreateVolumePermission(),
-			"aws_spot_datafeed_subscription":                      ec2.ResourceSpotDataFeedSubscription(),
-			"aws_spot_fleet_request":                              ec2.ResourceSpotFleetRequest(),
-			"aws_spot_instance_request":                           ec2.ResourceSpotInstanceRequest(),
-			"aws_subnet":                                          ec2.ResourceSubnet(),
-			"aws_volume_attachment":                               ec2.ResourceVolumeAttachment(),
-			"aws_vpc":                                             ec2.ResourceVPC(),
-			"aws_vpc_dhcp_options":                                ec2.ResourceVPCDHCPOptions(),
-			"aws_vpc_dhcp_options_association":                    ec2.ResourceVPCDHCPOptionsAssociation(),
-			"aws_vpc_endpoint":                                    ec2.ResourceVPCEndpoint(),
-			"aws_vpc_endpoint_connection_notification":            ec2.ResourceVPCEndpointConnectionNotification(),
-			"aws_vpc_endpoint_route_table_association":            ec2.ResourceVPCEndpointRouteTableAssociation(),
-			"aws_vpc_endpoint_service":                            ec2.ResourceVPCEndpointService(),
-			"aws_vpc_endpoint_service_allowed_principal":          ec2.ResourceVPCEndpointServiceAllowedPrincipal(),
-			"aws_vpc_endpoint_subnet_association":                 ec2.ResourceVPCEndpointSubnetAssociation(),
-			"aws_vpc_ipam":                                        ec2.ResourceVPCIpam(),
-			"aws_vpc_ipam_pool":                                   ec2.ResourceVPCIpamPool(),
-			"aws_vpc_ipam_pool_cidr_allocation":                   ec2.ResourceVPCIpamPoolCidrAllocation(),
-			"aws_vpc_ipam_pool_cidr":                              ec2.ResourceVPCIpamPoolCidr(),
-			"aws_vpc_ipam_scope":                                  ec2.ResourceVPCIpamScope(),
-			"aws_vpc_ipv4_cidr_block_association":                 ec2.ResourceVPCIPv4CIDRBlockAssociation(),
-			"aws_vpc_ipv6_cidr_block_association":                 ec2.ResourceVPCIPv6CIDRBlockAssociation(),
-			"aws_vpc_peering_connection":                          ec2.ResourceVPCPeeringConnection(),
-			"aws_vpc_peering_connection_accepter":                 ec2.ResourceVPCPeeringConnectionAccepter(),
-			"aws_vpc_peering_connection_options":                  ec2.ResourceVPCPeeringConnectionOptions(),
-			"aws_vpn_connection":                                  ec2.ResourceVPNConnection(),
-			"aws_vpn_connection_route":                            ec2.ResourceVPNConnectionRoute(),
-			"aws_vpn_gateway":                                     ec2.ResourceVPNGateway(),
-			"aws_vpn_gateway_attachment":                          ec2.ResourceVPNGatewayAttachment(),
-			"aws_vpn_gateway_route_propagation":                   ec2.ResourceVPNGatewayRoutePropagation(),
+			"aws_ami":                                              ec2.ResourceAMI(),
+			"aws_ami_copy":                                         ec2.ResourceAMICopy(),
+			"aws_ami_from_instance":                                ec2.ResourceAMIFromInstance(),
+			"aws_ami_launch_permission":                            ec2.ResourceAMILaunchPermission(),
+			"aws_customer_gateway":                                 ec2.ResourceCustomerGateway(),
+			"aws_default_network_acl":                              ec2.ResourceDefaultNetworkACL(),
+			"aws_default_route_table":                              ec2.ResourceDefaultRouteTable(),
+			"aws_default_security_group":                           ec2.ResourceDefaultSecurityGroup(),
+			"aws_default_subnet":                                   ec2.ResourceDefaultSubnet(),
+			"aws_default_vpc":                                      ec2.ResourceDefaultVPC(),
+			"aws_default_vpc_dhcp_options":                         ec2.ResourceDefaultVPCDHCPOptions(),
+			"aws_ebs_default_kms_key":                              ec2.ResourceEBSDefaultKMSKey(),
+			"aws_ebs_encryption_by_default":                        ec2.ResourceEBSEncryptionByDefault(),
+			"aws_ebs_snapshot":                                     ec2.ResourceEBSSnapshot(),
+			"aws_ebs_snapshot_copy":                                ec2.ResourceEBSSnapshotCopy(),
+			"aws_ebs_snapshot_import":                              ec2.ResourceEBSSnapshotImport(),
+			"aws_ebs_volume":                                       ec2.ResourceEBSVolume(),
+			"aws_ec2_availability_zone_group":                      ec2.ResourceAvailabilityZoneGroup(),
+			"aws_ec2_capacity_reservation":                         ec2.ResourceCapacityReservation(),
+			"aws_ec2_carrier_gateway":                              ec2.ResourceCarrierGateway(),
+			"aws_ec2_client_vpn_authorization_rule":                ec2.ResourceClientVPNAuthorizationRule(),
+			"aws_ec2_client_vpn_endpoint":                          ec2.ResourceClientVPNEndpoint(),
+			"aws_ec2_client_vpn_network_association":               ec2.ResourceClientVPNNetworkAssociation(),
+			"aws_ec2_client_vpn_route":                             ec2.ResourceClientVPNRoute(),
+			"aws_ec2_fleet":                                        ec2.ResourceFleet(),
+			"aws_ec2_host":                                         ec2.ResourceHost(),
+			"aws_ec2_instance_state":                               ec2.ResourceInstanceState(),
+			"aws_ec2_local_gateway_route":                          ec2.ResourceLocalGatewayRoute(),
+			"aws_ec2_local_gateway_route_table_vpc_association":    ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
+			"aws_ec2_managed_prefix_list":                          ec2.ResourceManagedPrefixList(),
+			"aws_ec2_managed_prefix_list_entry":                    ec2.ResourceManagedPrefixListEntry(),
+			"aws_ec2_subnet_cidr_reservation":                      ec2.ResourceSubnetCIDRReservation(),
+			"aws_ec2_tag":                                          ec2.ResourceTag(),
+			"aws_ec2_traffic_mirror_filter":                        ec2.ResourceTrafficMirrorFilter(),
+			"aws_ec2_traffic_mirror_filter_rule":                   ec2.ResourceTrafficMirrorFilterRule(),
+			"aws_ec2_traffic_mirror_session":                       ec2.ResourceTrafficMirrorSession(),
+			"aws_ec2_traffic_mirror_target":                        ec2.ResourceTrafficMirrorTarget(),
+			"aws_ec2_transit_gateway":                              ec2.ResourceTransitGateway(),
+			"aws_ec2_transit_gateway_connect":                      ec2.ResourceTransitGatewayConnect(),
+			"aws_ec2_transit_gateway_connect_peer":                 ec2.ResourceTransitGatewayConnectPeer(),
+			"aws_ec2_transit_gateway_multicast_domain":             ec2.ResourceTransitGatewayMulticastDomain(),
+			"aws_ec2_transit_gateway_multicast_domain_association": ec2.ResourceTransitGatewayMulticastDomainAssociation(),
+			"aws_ec2_transit_gateway_multicast_group_member":       ec2.ResourceTransitGatewayMulticastGroupMember(),
+			"aws_ec2_transit_gateway_multicast_group_source":       ec2.ResourceTransitGatewayMulticastGroupSource(),
+			"aws_ec2_transit_gateway_peering_attachment":           ec2.ResourceTransitGatewayPeeringAttachment(),
+			"aws_ec2_transit_gateway_peering_attachment_accepter":  ec2.ResourceTransitGatewayPeeringAttachmentAccepter(),
+			"aws_ec2_transit_gateway_prefix_list_reference":        ec2.ResourceTransitGatewayPrefixListReference(),
+			"aws_ec2_transit_gateway_route":                        ec2.ResourceTransitGatewayRoute(),
+			"aws_ec2_transit_gateway_route_table":                  ec2.ResourceTransitGatewayRouteTable(),
+			"aws_ec2_transit_gateway_route_table_association":      ec2.ResourceTransitGatewayRouteTableAssociation(),
+			"aws_ec2_transit_gateway_route_table_propagation":      ec2.ResourceTransitGatewayRouteTablePropagation(),
+			"aws_ec2_transit_gateway_vpc_attachment":               ec2.ResourceTransitGatewayVPCAttachment(),
+			"aws_ec2_transit_gateway_vpc_attachment_accepter":      ec2.ResourceTransitGatewayVPCAttachmentAccepter(),
+			"aws_egress_only_internet_gateway":                     ec2.ResourceEgressOnlyInternetGateway(),
+			"aws_eip":                                              ec2.ResourceEIP(),
+			"aws_eip_association":                                  ec2.ResourceEIPAssociation(),
+			"aws_flow_log":                                         ec2.ResourceFlowLog(),
+			"aws_instance":                                         ec2.ResourceInstance(),
+			"aws_internet_gateway":                                 ec2.ResourceInternetGateway(),
+			"aws_key_pair":                                         ec2.ResourceKeyPair(),
+			"aws_launch_template":                                  ec2.ResourceLaunchTemplate(),
+			"aws_main_route_table_association":                     ec2.ResourceMainRouteTableAssociation(),
+			"aws_nat_gateway":                                      ec2.ResourceNatGateway(),
+			"aws_network_acl":                                      ec2.ResourceNetworkACL(),
+			"aws_network_acl_rule":                                 ec2.ResourceNetworkACLRule(),
+			"aws_network_interface":                                ec2.ResourceNetworkInterface(),
+			"aws_network_interface_attachment":                     ec2.ResourceNetworkInterfaceAttachment(),
+			"aws_network_interface_sg_attachment":                  ec2.ResourceNetworkInterfaceSGAttachment(),
+			"aws_placement_group":                                  ec2.ResourcePlacementGroup(),
+			"aws_route":                                            ec2.ResourceRoute(),
+			"aws_route_table":                                      ec2.ResourceRouteTable(),
+			"aws_route_table_association":                          ec2.ResourceRouteTableAssociation(),
+			"aws_security_group":                                   ec2.ResourceSecurityGroup(),
+			"aws_security_group_rule":                              ec2.ResourceSecurityGroupRule(),
+			"aws_snapshot_create_volume_permission":                ec2.ResourceSnapshotCreateVolumePermission(),
+			"aws_spot_datafeed_subscription":                       ec2.ResourceSpotDataFeedSubscription(),
+			"aws_spot_fleet_request":                               ec2.ResourceSpotFleetRequest(),
+			"aws_spot_instance_request":                            ec2.ResourceSpotInstanceRequest(),
+			"aws_subnet":                                           ec2.ResourceSubnet(),
+			"aws_volume_attachment":                                ec2.ResourceVolumeAttachment(),
+			"aws_vpc":                                              ec2.ResourceVPC(),
+			"aws_vpc_dhcp_options":                                 ec2.ResourceVPCDHCPOptions(),
+			"aws_vpc_dhcp_options_association":                     ec2.ResourceVPCDHCPOptionsAssociation(),
+			"aws_vpc_endpoint":                                     ec2.ResourceVPCEndpoint(),
+			"aws_vpc_endpoint_connection_notification":             ec2.ResourceVPCEndpointConnectionNotification(),
+			"aws_vpc_endpoint_route_table_association":             ec2.ResourceVPCEndpointRouteTableAssociation(),
+			"aws_vpc_endpoint_service":                             ec2.ResourceVPCEndpointService(),
+			"aws_vpc_endpoint_service_allowed_principal":           ec2.ResourceVPCEndpointServiceAllowedPrincipal(),
+			"aws_vpc_endpoint_subnet_association":                  ec2.ResourceVPCEndpointSubnetAssociation(),
+			"aws_vpc_ipam":                                         ec2.ResourceVPCIpam(),
+			"aws_vpc_ipam_pool":                                    ec2.ResourceVPCIpamPool(),
+			"aws_vpc_ipam_pool_cidr_allocation":                    ec2.ResourceVPCIpamPoolCidrAllocation(),
+			"aws_vpc_ipam_pool_cidr":                               ec2.ResourceVPCIpamPoolCidr(),
+			"aws_vpc_ipam_scope":                                   ec2.ResourceVPCIpamScope(),
+			"aws_vpc_ipv4_cidr_block_association":                  ec2.ResourceVPCIPv4CIDRBlockAssociation(),
+			"aws_vpc_ipv6_cidr_block_association":                  ec2.ResourceVPCIPv6CIDRBlockAssociation(),
+			"aws_vpc_peering_connection":                           ec2.ResourceVPCPeeringConnection(),
+			"aws_vpc_peering_connection_accepter":                  ec2.ResourceVPCPeeringConnectionAccepter(),
+			"aws_vpc_peering_connection_options":                   ec2.ResourceVPCPeeringConnectionOptions(),
+			"aws_vpn_connection":                                   ec2.ResourceVPNConnection(),
+			"aws_vpn_connection_route":                             ec2.ResourceVPNConnectionRoute(),
+			"aws_vpn_gateway":                                      ec2.ResourceVPNGateway(),
+			"aws_vpn_gateway_attachment":                           ec2.ResourceVPNGatewayAttachment(),
+			"aws_vpn_gateway_route_propagation":                    ec2.ResourceVPNGatewayRoutePropagation(),
 
 			"aws_ecr_lifecycle_policy":          ecr.ResourceLifecyclePolicy(),
 			"aws_ecr_registry_policy":           ecr.ResourceRegistryPolicy(),
@@ -1129,13 +1150,14 @@ func Provider() *schema.Provider {
 
 			"aws_ecrpublic_repository": ecrpublic.ResourceRepository(),
 
-			"aws_ecs_account_setting_default": ecs.ResourceAccountSettingDefault(),
-			"aws_ecs_capacity_provider":       ecs.ResourceCapacityProvider(),
-			"aws_ecs_cluster":                 ecs.ResourceCluster(),
-			"aws_ecs_service":                 ecs.ResourceService(),
-			"aws_ecs_tag":                     ecs.ResourceTag(),
-			"aws_ecs_task_definition":         ecs.ResourceTaskDefinition(),
-			"aws_ecs_task_set":                ecs.ResourceTaskSet(),
+			"aws_ecs_account_setting_default":    ecs.ResourceAccountSettingDefault(),
+			"aws_ecs_capacity_provider":          ecs.ResourceCapacityProvider(),
+			"aws_ecs_cluster":                    ecs.ResourceCluster(),
+			"aws_ecs_cluster_capacity_providers": ecs.ResourceClusterCapacityProviders(),
+			"aws_ecs_service":                    ecs.ResourceService(),
+			"aws_ecs_tag":                        ecs.ResourceTag(),
+			"aws_ecs_task_definition":            ecs.ResourceTaskDefinition(),
+			"aws_ecs_task_set":                   ecs.ResourceTaskSet(),
 
 			"aws_efs_access_point":       efs.ResourceAccessPoint(),
 			"aws_efs_backup_policy":      efs.ResourceBackupPolicy(),
@@ -1304,6 +1326,11 @@ func Provider() *schema.Provider {
 			"aws_msk_cluster":                  kafka.ResourceCluster(),
 			"aws_msk_configuration":            kafka.ResourceConfiguration(),
 			"aws_msk_scram_secret_association": kafka.ResourceScramSecretAssociation(),
+			"aws_msk_serverless_cluster":       kafka.ResourceServerlessCluster(),
+
+			"aws_mskconnect_connector":            kafkaconnect.ResourceConnector(),
+			"aws_mskconnect_custom_plugin":        kafkaconnect.ResourceCustomPlugin(),
+			"aws_mskconnect_worker_configuration": kafkaconnect.ResourceWorkerConfiguration(),
 
 			"aws_kinesis_stream":          kinesis.ResourceStream(),
 			"aws_kinesis_stream_consumer": kinesis.ResourceStreamConsumer(),
@@ -1331,6 +1358,7 @@ func Provider() *schema.Provider {
 			"aws_lambda_event_source_mapping":           lambda.ResourceEventSourceMapping(),
 			"aws_lambda_function":                       lambda.ResourceFunction(),
 			"aws_lambda_function_event_invoke_config":   lambda.ResourceFunctionEventInvokeConfig(),
+			"aws_lambda_function_url":                   lambda.ResourceFunctionURL(),
 			"aws_lambda_layer_version":                  lambda.ResourceLayerVersion(),
 			"aws_lambda_layer_version_permission":       lambda.ResourceLayerVersionPermission(),
 			"aws_lambda_permission":                     lambda.ResourcePermission(),
@@ -1628,8 +1656,10 @@ func Provider() *schema.Provider {
 			"aws_sns_topic_policy":         sns.ResourceTopicPolicy(),
 			"aws_sns_topic_subscription":   sns.ResourceTopicSubscription(),
 
-			"aws_sqs_queue":        sqs.ResourceQueue(),
-			"aws_sqs_queue_policy": sqs.ResourceQueuePolicy(),
+			"aws_sqs_queue":                      sqs.ResourceQueue(),
+			"aws_sqs_queue_policy":               sqs.ResourceQueuePolicy(),
+			"aws_sqs_queue_redrive_allow_policy": sqs.ResourceQueueRedriveAllowPolicy(),
+			"aws_sqs_queue_redrive_policy":       sqs.ResourceQueueRedrivePolicy(),
 
 			"aws_ssm_activation":                ssm.ResourceActivation(),
 			"aws_ssm_association":               ssm.ResourceAssociation(),
@@ -0,0 +1,39 @@
+package backup
+
+// Deployment statuses shared by frameworks and report plans.
+const (
+	deploymentStatusCompleted        = "COMPLETED"
+	deploymentStatusCreateInProgress = "CREATE_IN_PROGRESS"
+	deploymentStatusDeleteInProgress = "DELETE_IN_PROGRESS"
+	deploymentStatusUpdateInProgress = "UPDATE_IN_PROGRESS"
+)
+
+const (
+	reportDeliveryChannelFormatCSV  = "CSV"
+	reportDeliveryChannelFormatJSON = "JSON"
+)
+
+func reportDeliveryChannelFormat_Values() []string {
+	return []string{
+		reportDeliveryChannelFormatCSV,
+		reportDeliveryChannelFormatJSON,
+	}
+}
+
+const (
+	reportSettingReportTemplateBackupJobReport          = "BACKUP_JOB_REPORT"
+	reportSettingReportTemplateControlComplianceReport  = "CONTROL_COMPLIANCE_REPORT"
+	reportSettingReportTemplateCopyJobReport            = "COPY_JOB_REPORT"
+	reportSettingReportTemplateResourceComplianceReport = "RESOURCE_COMPLIANCE_REPORT"
+	reportSettingReportTemplateRestoreJobReport         = "RESTORE_JOB_REPORT"
+)
+
+func reportSettingReportTemplate_Values() []string {
+	return []string{
+		reportSettingReportTemplateBackupJobReport,
+		reportSettingReportTemplateControlComplianceReport,
+		reportSettingReportTemplateCopyJobReport,
+		reportSettingReportTemplateResourceComplianceReport,
+		reportSettingReportTemplateRestoreJobReport,
+	}
+}
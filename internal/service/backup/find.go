@@ -57,3 +57,87 @@ func FindBackupVaultByName(conn *backup.Backup, name string) (*backup.DescribeBa
 
 	return output, nil
 }
+
+func FindFrameworkByName(conn *backup.Backup, name string) (*backup.DescribeFrameworkOutput, error) {
+	input := &backup.DescribeFrameworkInput{
+		FrameworkName: aws.String(name),
+	}
+
+	output, err := conn.DescribeFramework(input)
+
+	if tfawserr.ErrCodeEquals(err, backup.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindRecoveryPointsByBackupVaultName(conn *backup.Backup, name string) ([]*backup.RecoveryPointByBackupVault, error) {
+	input := &backup.ListRecoveryPointsByBackupVaultInput{
+		BackupVaultName: aws.String(name),
+	}
+	var output []*backup.RecoveryPointByBackupVault
+
+	err := conn.ListRecoveryPointsByBackupVaultPages(input, func(page *backup.ListRecoveryPointsByBackupVaultOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.RecoveryPoints {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, backup.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindReportPlanByName(conn *backup.Backup, name string) (*backup.ReportPlan, error) {
+	input := &backup.DescribeReportPlanInput{
+		ReportPlanName: aws.String(name),
+	}
+
+	output, err := conn.DescribeReportPlan(input)
+
+	if tfawserr.ErrCodeEquals(err, backup.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ReportPlan == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ReportPlan, nil
+}
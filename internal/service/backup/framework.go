@@ -0,0 +1,416 @@
+package backup
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFramework() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFrameworkCreate,
+		Read:   resourceFrameworkRead,
+		Update: resourceFrameworkUpdate,
+		Delete: resourceFrameworkDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"control": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input_parameter": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						"scope": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"compliance_resource_ids": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MinItems: 1,
+										MaxItems: 100,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"compliance_resource_types": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"tags": tftags.TagsSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z][_a-zA-Z0-9]*$`), "must start with a letter and consist of only alphanumeric characters and underscores"),
+				),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFrameworkCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BackupConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &backup.CreateFrameworkInput{
+		FrameworkControls: expandFrameworkControls(d.Get("control").(*schema.Set).List()),
+		FrameworkName:     aws.String(name),
+		IdempotencyToken:  aws.String(resource.UniqueId()),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.FrameworkDescription = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.FrameworkTags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Backup Framework: %s", input)
+	output, err := conn.CreateFramework(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Backup Framework (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.FrameworkName))
+
+	if _, err := waitFrameworkCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Backup Framework (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceFrameworkRead(d, meta)
+}
+
+func resourceFrameworkRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BackupConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	output, err := FindFrameworkByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Backup Framework (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Backup Framework (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.FrameworkArn)
+	if err := d.Set("control", flattenFrameworkControls(output.FrameworkControls)); err != nil {
+		return fmt.Errorf("error setting control: %w", err)
+	}
+	d.Set("creation_time", aws.TimeValue(output.CreationTime).Format(time.RFC3339))
+	d.Set("deployment_status", output.DeploymentStatus)
+	d.Set("description", output.FrameworkDescription)
+	d.Set("name", output.FrameworkName)
+	d.Set("status", output.FrameworkStatus)
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Backup Framework (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceFrameworkUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BackupConn
+
+	if d.HasChanges("control", "description") {
+		input := &backup.UpdateFrameworkInput{
+			FrameworkControls:    expandFrameworkControls(d.Get("control").(*schema.Set).List()),
+			FrameworkDescription: aws.String(d.Get("description").(string)),
+			FrameworkName:        aws.String(d.Id()),
+			IdempotencyToken:     aws.String(resource.UniqueId()),
+		}
+
+		log.Printf("[DEBUG] Updating Backup Framework: %s", input)
+		_, err := conn.UpdateFramework(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Backup Framework (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitFrameworkUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Backup Framework (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating tags for Backup Framework (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceFrameworkRead(d, meta)
+}
+
+func resourceFrameworkDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BackupConn
+
+	log.Printf("[DEBUG] Deleting Backup Framework: %s", d.Id())
+	_, err := conn.DeleteFramework(&backup.DeleteFrameworkInput{
+		FrameworkName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, backup.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Backup Framework (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitFrameworkDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Backup Framework (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandFrameworkControls(tfList []interface{}) []*backup.FrameworkControl {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*backup.FrameworkControl
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &backup.FrameworkControl{
+			ControlName: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["input_parameter"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ControlInputParameters = expandControlInputParameters(v.List())
+		}
+
+		if v, ok := tfMap["scope"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ControlScope = expandControlScope(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandControlInputParameters(tfList []interface{}) []*backup.ControlInputParameter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*backup.ControlInputParameter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &backup.ControlInputParameter{}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.ParameterName = aws.String(v)
+		}
+
+		if v, ok := tfMap["value"].(string); ok && v != "" {
+			apiObject.ParameterValue = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandControlScope(tfMap map[string]interface{}) *backup.ControlScope {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &backup.ControlScope{}
+
+	if v, ok := tfMap["compliance_resource_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ComplianceResourceIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["compliance_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ComplianceResourceTypes = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["tags"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Tags = Tags(tftags.New(v).IgnoreAWS())
+	}
+
+	return apiObject
+}
+
+func flattenFrameworkControls(apiObjects []*backup.FrameworkControl) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"input_parameter": flattenControlInputParameters(apiObject.ControlInputParameters),
+			"name":            aws.StringValue(apiObject.ControlName),
+			"scope":           flattenControlScope(apiObject.ControlScope),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenControlInputParameters(apiObjects []*backup.ControlInputParameter) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name":  aws.StringValue(apiObject.ParameterName),
+			"value": aws.StringValue(apiObject.ParameterValue),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenControlScope(apiObject *backup.ControlScope) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"compliance_resource_ids":   flex.FlattenStringList(apiObject.ComplianceResourceIds),
+		"compliance_resource_types": flex.FlattenStringList(apiObject.ComplianceResourceTypes),
+		"tags":                      KeyValueTags(apiObject.Tags).IgnoreAWS().Map(),
+	}
+
+	return []interface{}{tfMap}
+}
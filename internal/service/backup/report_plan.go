@@ -0,0 +1,343 @@
+package backup
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceReportPlan() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceReportPlanCreate,
+		Read:   resourceReportPlanRead,
+		Update: resourceReportPlanUpdate,
+		Delete: resourceReportPlanDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z][_a-zA-Z0-9]*$`), "must start with a letter and consist of only alphanumeric characters and underscores"),
+				),
+			},
+			"report_delivery_channel": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"formats": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(reportDeliveryChannelFormat_Values(), false),
+							},
+						},
+						"s3_bucket_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"s3_key_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"report_setting": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"framework_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+						"number_of_frameworks": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"report_template": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(reportSettingReportTemplate_Values(), false),
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceReportPlanCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BackupConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &backup.CreateReportPlanInput{
+		IdempotencyToken:      aws.String(resource.UniqueId()),
+		ReportDeliveryChannel: expandReportDeliveryChannel(d.Get("report_delivery_channel").([]interface{})),
+		ReportPlanName:        aws.String(name),
+		ReportSetting:         expandReportSetting(d.Get("report_setting").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.ReportPlanDescription = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.ReportPlanTags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Backup Report Plan: %s", input)
+	output, err := conn.CreateReportPlan(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Backup Report Plan (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ReportPlanName))
+
+	if _, err := waitReportPlanCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Backup Report Plan (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceReportPlanRead(d, meta)
+}
+
+func resourceReportPlanRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BackupConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	reportPlan, err := FindReportPlanByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Backup Report Plan (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Backup Report Plan (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", reportPlan.ReportPlanArn)
+	d.Set("creation_time", aws.TimeValue(reportPlan.CreationTime).Format(time.RFC3339))
+	d.Set("deployment_status", reportPlan.DeploymentStatus)
+	d.Set("description", reportPlan.ReportPlanDescription)
+	d.Set("name", reportPlan.ReportPlanName)
+
+	if err := d.Set("report_delivery_channel", flattenReportDeliveryChannel(reportPlan.ReportDeliveryChannel)); err != nil {
+		return fmt.Errorf("error setting report_delivery_channel: %w", err)
+	}
+
+	if err := d.Set("report_setting", flattenReportSetting(reportPlan.ReportSetting)); err != nil {
+		return fmt.Errorf("error setting report_setting: %w", err)
+	}
+
+	tags, err := ListTags(conn, d.Get("arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Backup Report Plan (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceReportPlanUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BackupConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &backup.UpdateReportPlanInput{
+			IdempotencyToken:      aws.String(resource.UniqueId()),
+			ReportDeliveryChannel: expandReportDeliveryChannel(d.Get("report_delivery_channel").([]interface{})),
+			ReportPlanDescription: aws.String(d.Get("description").(string)),
+			ReportPlanName:        aws.String(d.Id()),
+			ReportSetting:         expandReportSetting(d.Get("report_setting").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating Backup Report Plan: %s", input)
+		_, err := conn.UpdateReportPlan(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Backup Report Plan (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitReportPlanUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for Backup Report Plan (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating tags for Backup Report Plan (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceReportPlanRead(d, meta)
+}
+
+func resourceReportPlanDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BackupConn
+
+	log.Printf("[DEBUG] Deleting Backup Report Plan: %s", d.Id())
+	_, err := conn.DeleteReportPlan(&backup.DeleteReportPlanInput{
+		ReportPlanName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, backup.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Backup Report Plan (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitReportPlanDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Backup Report Plan (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandReportDeliveryChannel(tfList []interface{}) *backup.ReportDeliveryChannel {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &backup.ReportDeliveryChannel{
+		S3BucketName: aws.String(tfMap["s3_bucket_name"].(string)),
+	}
+
+	if v, ok := tfMap["formats"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Formats = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["s3_key_prefix"].(string); ok && v != "" {
+		apiObject.S3KeyPrefix = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandReportSetting(tfList []interface{}) *backup.ReportSetting {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &backup.ReportSetting{
+		ReportTemplate: aws.String(tfMap["report_template"].(string)),
+	}
+
+	if v, ok := tfMap["framework_arns"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FrameworkArns = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["number_of_frameworks"].(int); ok && v > 0 {
+		apiObject.NumberOfFrameworks = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenReportDeliveryChannel(apiObject *backup.ReportDeliveryChannel) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"formats":        flex.FlattenStringSet(apiObject.Formats),
+		"s3_bucket_name": aws.StringValue(apiObject.S3BucketName),
+		"s3_key_prefix":  aws.StringValue(apiObject.S3KeyPrefix),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenReportSetting(apiObject *backup.ReportSetting) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"framework_arns":       flex.FlattenStringSet(apiObject.FrameworkArns),
+		"number_of_frameworks": aws.Int64Value(apiObject.NumberOfFrameworks),
+		"report_template":      aws.StringValue(apiObject.ReportTemplate),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -2,6 +2,7 @@ package backup
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/backup"
@@ -23,10 +24,62 @@ func DataSourceVault() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"include_recovery_points": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"kms_key_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"recovery_point": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_size_in_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"completion_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"delete_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"encryption_key_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"move_to_cold_storage_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"recovery_points": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -56,6 +109,20 @@ func dataSourceVaultRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("name", resp.BackupVaultName)
 	d.Set("recovery_points", resp.NumberOfRecoveryPoints)
 
+	if d.Get("include_recovery_points").(bool) {
+		recoveryPoints, err := FindRecoveryPointsByBackupVaultName(conn, name)
+
+		if err != nil {
+			return fmt.Errorf("error listing recovery points for Backup Vault (%s): %w", name, err)
+		}
+
+		if err := d.Set("recovery_point", flattenRecoveryPointsByBackupVault(recoveryPoints)); err != nil {
+			return fmt.Errorf("error setting recovery_point: %w", err)
+		}
+	} else {
+		d.Set("recovery_point", nil)
+	}
+
 	tags, err := ListTags(conn, aws.StringValue(resp.BackupVaultArn))
 	if err != nil {
 		return fmt.Errorf("error listing tags for Backup Vault (%s): %w", name, err)
@@ -66,3 +133,48 @@ func dataSourceVaultRead(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func flattenRecoveryPointsByBackupVault(apiObjects []*backup.RecoveryPointByBackupVault) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"arn":                  aws.StringValue(apiObject.RecoveryPointArn),
+			"backup_size_in_bytes": aws.Int64Value(apiObject.BackupSizeInBytes),
+			"encryption_key_arn":   aws.StringValue(apiObject.EncryptionKeyArn),
+			"resource_arn":         aws.StringValue(apiObject.ResourceArn),
+			"resource_type":        aws.StringValue(apiObject.ResourceType),
+			"status":               aws.StringValue(apiObject.Status),
+		}
+
+		if v := apiObject.CompletionDate; v != nil {
+			tfMap["completion_date"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.CreationDate; v != nil {
+			tfMap["creation_date"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.CalculatedLifecycle; v != nil {
+			if v := v.DeleteAt; v != nil {
+				tfMap["delete_at"] = aws.TimeValue(v).Format(time.RFC3339)
+			}
+
+			if v := v.MoveToColdStorageAt; v != nil {
+				tfMap["move_to_cold_storage_at"] = aws.TimeValue(v).Format(time.RFC3339)
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -32,6 +32,7 @@ func TestAccBackupVaultDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(datasourceName, "arn", resourceName, "arn"),
 					resource.TestCheckResourceAttrPair(datasourceName, "kms_key_arn", resourceName, "kms_key_arn"),
 					resource.TestCheckResourceAttrPair(datasourceName, "recovery_points", resourceName, "recovery_points"),
+					resource.TestCheckResourceAttr(datasourceName, "recovery_point.#", "0"),
 					resource.TestCheckResourceAttrPair(datasourceName, "tags.%", resourceName, "tags.%"),
 				),
 			},
@@ -39,6 +40,29 @@ func TestAccBackupVaultDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccBackupVaultDataSource_includeRecoveryPoints(t *testing.T) {
+	datasourceName := "data.aws_backup_vault.test"
+	resourceName := "aws_backup_vault.test"
+	rInt := sdkacctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, backup.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVaultDataSourceConfig_includeRecoveryPoints(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(datasourceName, "include_recovery_points", "true"),
+					resource.TestCheckResourceAttrPair(datasourceName, "recovery_points", resourceName, "recovery_points"),
+					resource.TestCheckResourceAttr(datasourceName, "recovery_point.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 const testAccVaultDataSourceConfig_nonExistent = `
 data "aws_backup_vault" "test" {
   name = "tf-acc-test-does-not-exist"
@@ -61,3 +85,16 @@ data "aws_backup_vault" "test" {
 }
 `, rInt)
 }
+
+func testAccVaultDataSourceConfig_includeRecoveryPoints(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_backup_vault" "test" {
+  name = "tf_acc_test_backup_vault_%d"
+}
+
+data "aws_backup_vault" "test" {
+  name                    = aws_backup_vault.test.name
+  include_recovery_points = true
+}
+`, rInt)
+}
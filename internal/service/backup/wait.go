@@ -2,9 +2,114 @@ package backup
 
 import (
 	"time"
+
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 const (
 	// Maximum amount of time to wait for Backup changes to propagate
 	propagationTimeout = 2 * time.Minute
 )
+
+func waitFrameworkCreated(conn *backup.Backup, name string, timeout time.Duration) (*backup.DescribeFrameworkOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{deploymentStatusCreateInProgress},
+		Target:  []string{deploymentStatusCompleted},
+		Refresh: statusFramework(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*backup.DescribeFrameworkOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFrameworkUpdated(conn *backup.Backup, name string, timeout time.Duration) (*backup.DescribeFrameworkOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{deploymentStatusUpdateInProgress},
+		Target:  []string{deploymentStatusCompleted},
+		Refresh: statusFramework(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*backup.DescribeFrameworkOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFrameworkDeleted(conn *backup.Backup, name string, timeout time.Duration) (*backup.DescribeFrameworkOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{deploymentStatusDeleteInProgress},
+		Target:  []string{},
+		Refresh: statusFramework(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*backup.DescribeFrameworkOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReportPlanCreated(conn *backup.Backup, name string, timeout time.Duration) (*backup.ReportPlan, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{deploymentStatusCreateInProgress},
+		Target:  []string{deploymentStatusCompleted},
+		Refresh: statusReportPlan(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*backup.ReportPlan); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReportPlanUpdated(conn *backup.Backup, name string, timeout time.Duration) (*backup.ReportPlan, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{deploymentStatusUpdateInProgress},
+		Target:  []string{deploymentStatusCompleted},
+		Refresh: statusReportPlan(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*backup.ReportPlan); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReportPlanDeleted(conn *backup.Backup, name string, timeout time.Duration) (*backup.ReportPlan, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{deploymentStatusDeleteInProgress},
+		Target:  []string{},
+		Refresh: statusReportPlan(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*backup.ReportPlan); ok {
+		return output, err
+	}
+
+	return nil, err
+}
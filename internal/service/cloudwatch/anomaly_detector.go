@@ -0,0 +1,473 @@
+package cloudwatch
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceAnomalyDetector() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAnomalyDetectorCreate,
+		ReadWithoutTimeout:   resourceAnomalyDetectorRead,
+		UpdateWithoutTimeout: resourceAnomalyDetectorUpdate,
+		DeleteWithoutTimeout: resourceAnomalyDetectorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"excluded_time_range": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"end_time": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     validation.IsRFC3339Time,
+										DiffSuppressFunc: verify.SuppressEquivalentRFC3339Time,
+									},
+									"start_time": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     validation.IsRFC3339Time,
+										DiffSuppressFunc: verify.SuppressEquivalentRFC3339Time,
+									},
+								},
+							},
+						},
+						"metric_timezone": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 50),
+						},
+					},
+				},
+			},
+			"metric_math_anomaly_detector": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"metric_math_anomaly_detector", "single_metric_anomaly_detector"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_data_query": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     metricDataQueryResource(),
+						},
+					},
+				},
+			},
+			"single_metric_anomaly_detector": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"metric_math_anomaly_detector", "single_metric_anomaly_detector"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dimensions": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"metric_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+						"namespace": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 255),
+								validation.StringMatch(regexp.MustCompile(`[^:].*`), "must not contain colon characters"),
+							),
+						},
+						"stat": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.Any(
+								validation.StringInSlice(cloudwatch.Statistic_Values(), false),
+								validation.StringMatch(regexp.MustCompile(`p(\d{1,2}(\.\d{0,2})?|100)`), "must specify a value between p0.0 and p100"),
+							),
+						},
+					},
+				},
+			},
+			"state_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAnomalyDetectorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudWatchConn
+
+	single, math := expandAnomalyDetectorDefinition(d.Get("single_metric_anomaly_detector").([]interface{}), d.Get("metric_math_anomaly_detector").([]interface{}))
+	id, err := anomalyDetectorCreateResourceID(single, math)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := putAnomalyDetector(ctx, conn, d); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating CloudWatch Anomaly Detector (%s): %w", id, err))
+	}
+
+	d.SetId(id)
+
+	return resourceAnomalyDetectorRead(ctx, d, meta)
+}
+
+func resourceAnomalyDetectorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudWatchConn
+
+	anomalyDetector, err := FindAnomalyDetectorByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Anomaly Detector (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading CloudWatch Anomaly Detector (%s): %w", d.Id(), err))
+	}
+
+	if err := d.Set("configuration", flattenAnomalyDetectorConfiguration(anomalyDetector.Configuration)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting configuration: %w", err))
+	}
+
+	if anomalyDetector.MetricMathAnomalyDetector != nil {
+		tfMap := map[string]interface{}{
+			"metric_data_query": flattenMetricAlarmMetrics(anomalyDetector.MetricMathAnomalyDetector.MetricDataQueries),
+		}
+		if err := d.Set("metric_math_anomaly_detector", []interface{}{tfMap}); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting metric_math_anomaly_detector: %w", err))
+		}
+	} else {
+		d.Set("metric_math_anomaly_detector", nil)
+	}
+
+	if anomalyDetector.SingleMetricAnomalyDetector != nil {
+		if err := d.Set("single_metric_anomaly_detector", []interface{}{flattenSingleMetricAnomalyDetector(anomalyDetector.SingleMetricAnomalyDetector)}); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting single_metric_anomaly_detector: %w", err))
+		}
+	} else {
+		d.Set("single_metric_anomaly_detector", nil)
+	}
+
+	d.Set("state_value", anomalyDetector.StateValue)
+
+	return nil
+}
+
+func resourceAnomalyDetectorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudWatchConn
+
+	// Changing the metric definition results in a different anomaly detector, so remove the old one first.
+	if d.HasChanges("metric_math_anomaly_detector", "single_metric_anomaly_detector") {
+		oSingle, nSingle := d.GetChange("single_metric_anomaly_detector")
+		oMath, nMath := d.GetChange("metric_math_anomaly_detector")
+		single, math := expandAnomalyDetectorDefinition(nSingle.([]interface{}), nMath.([]interface{}))
+		id, err := anomalyDetectorCreateResourceID(single, math)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		single, math = expandAnomalyDetectorDefinition(oSingle.([]interface{}), oMath.([]interface{}))
+
+		if err := deleteAnomalyDetector(ctx, conn, single, math); err != nil {
+			return diag.FromErr(fmt.Errorf("error deleting CloudWatch Anomaly Detector (%s): %w", d.Id(), err))
+		}
+
+		d.SetId(id)
+	}
+
+	if err := putAnomalyDetector(ctx, conn, d); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating CloudWatch Anomaly Detector (%s): %w", d.Id(), err))
+	}
+
+	return resourceAnomalyDetectorRead(ctx, d, meta)
+}
+
+func resourceAnomalyDetectorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudWatchConn
+
+	single, math := expandAnomalyDetectorDefinition(d.Get("single_metric_anomaly_detector").([]interface{}), d.Get("metric_math_anomaly_detector").([]interface{}))
+
+	log.Printf("[DEBUG] Deleting CloudWatch Anomaly Detector: %s", d.Id())
+	if err := deleteAnomalyDetector(ctx, conn, single, math); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting CloudWatch Anomaly Detector (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func putAnomalyDetector(ctx context.Context, conn *cloudwatch.CloudWatch, d *schema.ResourceData) error {
+	single, math := expandAnomalyDetectorDefinition(d.Get("single_metric_anomaly_detector").([]interface{}), d.Get("metric_math_anomaly_detector").([]interface{}))
+	input := &cloudwatch.PutAnomalyDetectorInput{
+		MetricMathAnomalyDetector:   math,
+		SingleMetricAnomalyDetector: single,
+	}
+
+	if v, ok := d.GetOk("configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Configuration = expandAnomalyDetectorConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Putting CloudWatch Anomaly Detector: %s", input)
+	_, err := conn.PutAnomalyDetectorWithContext(ctx, input)
+
+	return err
+}
+
+func deleteAnomalyDetector(ctx context.Context, conn *cloudwatch.CloudWatch, single *cloudwatch.SingleMetricAnomalyDetector, math *cloudwatch.MetricMathAnomalyDetector) error {
+	_, err := conn.DeleteAnomalyDetectorWithContext(ctx, &cloudwatch.DeleteAnomalyDetectorInput{
+		MetricMathAnomalyDetector:   math,
+		SingleMetricAnomalyDetector: single,
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatch.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	return err
+}
+
+const anomalyDetectorResourceIDSeparator = ","
+
+// anomalyDetectorCreateResourceID returns the ID of the anomaly detector with the given metric definition,
+// as anomaly detectors have no identifier of their own.
+// A single metric anomaly detector is identified by its namespace, metric name, statistic and dimensions sorted by name,
+// e.g. "AWS/EC2,CPUUtilization,Average,InstanceId=i-abc123".
+// A metric math anomaly detector is identified by a hash of its metric data queries.
+func anomalyDetectorCreateResourceID(single *cloudwatch.SingleMetricAnomalyDetector, math *cloudwatch.MetricMathAnomalyDetector) (string, error) {
+	if single != nil {
+		parts := []string{aws.StringValue(single.Namespace), aws.StringValue(single.MetricName), aws.StringValue(single.Stat)}
+
+		dimensions := flattenMetricAlarmDimensions(single.Dimensions)
+		names := make([]string, 0, len(dimensions))
+		for name := range dimensions {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			parts = append(parts, fmt.Sprintf("%s=%s", name, dimensions[name]))
+		}
+
+		return strings.Join(parts, anomalyDetectorResourceIDSeparator), nil
+	}
+
+	if math != nil {
+		queries := flattenMetricAlarmMetrics(math.MetricDataQueries)
+		sort.Slice(queries, func(i, j int) bool {
+			return queries[i]["id"].(string) < queries[j]["id"].(string)
+		})
+
+		b, err := json.Marshal(queries)
+
+		if err != nil {
+			return "", fmt.Errorf("error hashing CloudWatch Anomaly Detector metric data queries: %w", err)
+		}
+
+		hash := sha256.Sum256(b)
+
+		return hex.EncodeToString(hash[:]), nil
+	}
+
+	return "", fmt.Errorf("one of single_metric_anomaly_detector or metric_math_anomaly_detector must be specified")
+}
+
+// anomalyDetectorParseResourceID returns the metric definition of a single metric anomaly detector,
+// or nil for the metric data query hash of a metric math anomaly detector.
+func anomalyDetectorParseResourceID(id string) (*cloudwatch.SingleMetricAnomalyDetector, error) {
+	parts := strings.Split(id, anomalyDetectorResourceIDSeparator)
+
+	if len(parts) == 1 && parts[0] != "" {
+		return nil, nil
+	}
+
+	err := fmt.Errorf("unexpected format for ID (%[1]s), expected NAMESPACE%[2]sMETRIC_NAME%[2]sSTAT[%[2]sDIMENSION_NAME=DIMENSION_VALUE...] or METRIC_DATA_QUERY_HASH", id, anomalyDetectorResourceIDSeparator)
+
+	if len(parts) < 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, err
+	}
+
+	apiObject := &cloudwatch.SingleMetricAnomalyDetector{
+		MetricName: aws.String(parts[1]),
+		Namespace:  aws.String(parts[0]),
+		Stat:       aws.String(parts[2]),
+	}
+
+	for _, part := range parts[3:] {
+		dimension := strings.SplitN(part, "=", 2)
+
+		if len(dimension) != 2 || dimension[0] == "" {
+			return nil, err
+		}
+
+		apiObject.Dimensions = append(apiObject.Dimensions, &cloudwatch.Dimension{
+			Name:  aws.String(dimension[0]),
+			Value: aws.String(dimension[1]),
+		})
+	}
+
+	return apiObject, nil
+}
+
+func expandAnomalyDetectorDefinition(singleList, mathList []interface{}) (*cloudwatch.SingleMetricAnomalyDetector, *cloudwatch.MetricMathAnomalyDetector) {
+	if len(singleList) > 0 && singleList[0] != nil {
+		return expandSingleMetricAnomalyDetector(singleList[0].(map[string]interface{})), nil
+	}
+
+	if len(mathList) > 0 && mathList[0] != nil {
+		tfMap := mathList[0].(map[string]interface{})
+		apiObject := &cloudwatch.MetricMathAnomalyDetector{}
+
+		if v, ok := tfMap["metric_data_query"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.MetricDataQueries = expandCloudWatchMetricAlarmMetrics(v)
+		}
+
+		return nil, apiObject
+	}
+
+	return nil, nil
+}
+
+func expandSingleMetricAnomalyDetector(tfMap map[string]interface{}) *cloudwatch.SingleMetricAnomalyDetector {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudwatch.SingleMetricAnomalyDetector{}
+
+	if v, ok := tfMap["dimensions"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Dimensions = expandMetricAlarmDimensions(v)
+	}
+
+	if v, ok := tfMap["metric_name"].(string); ok && v != "" {
+		apiObject.MetricName = aws.String(v)
+	}
+
+	if v, ok := tfMap["namespace"].(string); ok && v != "" {
+		apiObject.Namespace = aws.String(v)
+	}
+
+	if v, ok := tfMap["stat"].(string); ok && v != "" {
+		apiObject.Stat = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandAnomalyDetectorConfiguration(tfMap map[string]interface{}) *cloudwatch.AnomalyDetectorConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudwatch.AnomalyDetectorConfiguration{}
+
+	if v, ok := tfMap["excluded_time_range"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			startTime, _ := time.Parse(time.RFC3339, tfMap["start_time"].(string))
+			endTime, _ := time.Parse(time.RFC3339, tfMap["end_time"].(string))
+
+			apiObject.ExcludedTimeRanges = append(apiObject.ExcludedTimeRanges, &cloudwatch.Range{
+				EndTime:   aws.Time(endTime),
+				StartTime: aws.Time(startTime),
+			})
+		}
+	}
+
+	if v, ok := tfMap["metric_timezone"].(string); ok && v != "" {
+		apiObject.MetricTimezone = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSingleMetricAnomalyDetector(apiObject *cloudwatch.SingleMetricAnomalyDetector) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"dimensions": flattenMetricAlarmDimensions(apiObject.Dimensions),
+	}
+
+	if v := apiObject.MetricName; v != nil {
+		tfMap["metric_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Namespace; v != nil {
+		tfMap["namespace"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Stat; v != nil {
+		tfMap["stat"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenAnomalyDetectorConfiguration(apiObject *cloudwatch.AnomalyDetectorConfiguration) []interface{} {
+	if apiObject == nil || (len(apiObject.ExcludedTimeRanges) == 0 && aws.StringValue(apiObject.MetricTimezone) == "") {
+		return nil
+	}
+
+	var ranges []interface{}
+
+	for _, v := range apiObject.ExcludedTimeRanges {
+		if v == nil {
+			continue
+		}
+
+		ranges = append(ranges, map[string]interface{}{
+			"end_time":   aws.TimeValue(v.EndTime).Format(time.RFC3339),
+			"start_time": aws.TimeValue(v.StartTime).Format(time.RFC3339),
+		})
+	}
+
+	tfMap := map[string]interface{}{
+		"excluded_time_range": ranges,
+		"metric_timezone":     aws.StringValue(apiObject.MetricTimezone),
+	}
+
+	return []interface{}{tfMap}
+}
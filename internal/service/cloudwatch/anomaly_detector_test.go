@@ -0,0 +1,327 @@
+package cloudwatch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudwatch "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatch"
+)
+
+func TestAccCloudWatchAnomalyDetector_basic(t *testing.T) {
+	var anomalyDetector cloudwatch.AnomalyDetector
+	resourceName := "aws_cloudwatch_anomaly_detector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalyDetectorDestroy(rName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_singleMetric(rName, "Average"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSingleMetricAnomalyDetectorExists(rName, "Average", &anomalyDetector),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s,CPUUtilization,Average,InstanceId=i-abc123", rName)),
+					resource.TestCheckResourceAttr(resourceName, "metric_math_anomaly_detector.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.0.dimensions.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.0.dimensions.InstanceId", "i-abc123"),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.0.metric_name", "CPUUtilization"),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.0.namespace", rName),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.0.stat", "Average"),
+					resource.TestCheckResourceAttrSet(resourceName, "state_value"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAnomalyDetectorConfig_singleMetric(rName, "Maximum"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSingleMetricAnomalyDetectorExists(rName, "Maximum", &anomalyDetector),
+					testAccCheckSingleMetricAnomalyDetectorNotExists(rName, "Average"),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s,CPUUtilization,Maximum,InstanceId=i-abc123", rName)),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.0.stat", "Maximum"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchAnomalyDetector_disappears(t *testing.T) {
+	var anomalyDetector cloudwatch.AnomalyDetector
+	resourceName := "aws_cloudwatch_anomaly_detector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalyDetectorDestroy(rName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_singleMetric(rName, "Average"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSingleMetricAnomalyDetectorExists(rName, "Average", &anomalyDetector),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcloudwatch.ResourceAnomalyDetector(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchAnomalyDetector_configuration(t *testing.T) {
+	var anomalyDetector cloudwatch.AnomalyDetector
+	resourceName := "aws_cloudwatch_anomaly_detector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalyDetectorDestroy(rName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_configuration(rName, "UTC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSingleMetricAnomalyDetectorExists(rName, "Average", &anomalyDetector),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.0.end_time", "2022-01-02T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.excluded_time_range.0.start_time", "2022-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.metric_timezone", "UTC"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAnomalyDetectorConfig_configuration(rName, "Europe/London"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSingleMetricAnomalyDetectorExists(rName, "Average", &anomalyDetector),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.metric_timezone", "Europe/London"),
+				),
+			},
+			{
+				// The same excluded time range, expressed with a +02:00 offset.
+				Config:   testAccAnomalyDetectorConfig_configurationTimeOffset(rName, "Europe/London"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccAnomalyDetectorConfig_singleMetric(rName, "Average"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSingleMetricAnomalyDetectorExists(rName, "Average", &anomalyDetector),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchAnomalyDetector_metricMath(t *testing.T) {
+	resourceName := "aws_cloudwatch_anomaly_detector.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalyDetectorDestroy(rName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalyDetectorConfig_metricMath(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "metric_math_anomaly_detector.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metric_math_anomaly_detector.0.metric_data_query.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "metric_math_anomaly_detector.0.metric_data_query.*", map[string]string{
+						"id":          "e1",
+						"expression":  "m1 * 2",
+						"return_data": "true",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "single_metric_anomaly_detector.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "state_value"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSingleMetricAnomalyDetectorExists(namespace, stat string, v *cloudwatch.AnomalyDetector) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchConn
+
+		output, err := tfcloudwatch.FindAnomalyDetector(context.Background(), conn, testAccAnomalyDetectorSingleMetric(namespace, stat), nil)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSingleMetricAnomalyDetectorNotExists(namespace, stat string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchConn
+
+		_, err := tfcloudwatch.FindAnomalyDetector(context.Background(), conn, testAccAnomalyDetectorSingleMetric(namespace, stat), nil)
+
+		if err == nil {
+			return fmt.Errorf("CloudWatch Anomaly Detector (%s/%s) still exists", namespace, stat)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckAnomalyDetectorDestroy verifies that no anomaly detectors remain for the test's metric namespace.
+func testAccCheckAnomalyDetectorDestroy(namespace string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchConn
+
+		output, err := conn.DescribeAnomalyDetectors(&cloudwatch.DescribeAnomalyDetectorsInput{
+			Namespace: aws.String(namespace),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.AnomalyDetectors) > 0 {
+			return fmt.Errorf("CloudWatch Anomaly Detectors still exist in namespace %s", namespace)
+		}
+
+		return nil
+	}
+}
+
+func testAccAnomalyDetectorSingleMetric(namespace, stat string) *cloudwatch.SingleMetricAnomalyDetector {
+	return &cloudwatch.SingleMetricAnomalyDetector{
+		Dimensions: []*cloudwatch.Dimension{
+			{
+				Name:  aws.String("InstanceId"),
+				Value: aws.String("i-abc123"),
+			},
+		},
+		MetricName: aws.String("CPUUtilization"),
+		Namespace:  aws.String(namespace),
+		Stat:       aws.String(stat),
+	}
+}
+
+func testAccAnomalyDetectorConfig_singleMetric(rName, stat string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_anomaly_detector" "test" {
+  single_metric_anomaly_detector {
+    namespace   = %[1]q
+    metric_name = "CPUUtilization"
+    stat        = %[2]q
+
+    dimensions = {
+      InstanceId = "i-abc123"
+    }
+  }
+}
+`, rName, stat)
+}
+
+func testAccAnomalyDetectorConfig_configuration(rName, timezone string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_anomaly_detector" "test" {
+  single_metric_anomaly_detector {
+    namespace   = %[1]q
+    metric_name = "CPUUtilization"
+    stat        = "Average"
+
+    dimensions = {
+      InstanceId = "i-abc123"
+    }
+  }
+
+  configuration {
+    metric_timezone = %[2]q
+
+    excluded_time_range {
+      start_time = "2022-01-01T00:00:00Z"
+      end_time   = "2022-01-02T00:00:00Z"
+    }
+  }
+}
+`, rName, timezone)
+}
+
+func testAccAnomalyDetectorConfig_configurationTimeOffset(rName, timezone string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_anomaly_detector" "test" {
+  single_metric_anomaly_detector {
+    namespace   = %[1]q
+    metric_name = "CPUUtilization"
+    stat        = "Average"
+
+    dimensions = {
+      InstanceId = "i-abc123"
+    }
+  }
+
+  configuration {
+    metric_timezone = %[2]q
+
+    excluded_time_range {
+      start_time = "2022-01-01T02:00:00+02:00"
+      end_time   = "2022-01-02T02:00:00+02:00"
+    }
+  }
+}
+`, rName, timezone)
+}
+
+func testAccAnomalyDetectorConfig_metricMath(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_anomaly_detector" "test" {
+  metric_math_anomaly_detector {
+    metric_data_query {
+      id = "m1"
+
+      metric {
+        namespace   = %[1]q
+        metric_name = "CPUUtilization"
+        period      = 300
+        stat        = "Average"
+
+        dimensions = {
+          InstanceId = "i-abc123"
+        }
+      }
+    }
+
+    metric_data_query {
+      id          = "e1"
+      expression  = "m1 * 2"
+      return_data = true
+    }
+  }
+}
+`, rName)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func FindCompositeAlarmByName(ctx context.Context, conn *cloudwatch.CloudWatch, name string) (*cloudwatch.CompositeAlarm, error) {
@@ -42,3 +43,97 @@ func FindMetricAlarmByName(conn *cloudwatch.CloudWatch, name string) (*cloudwatc
 
 	return output.MetricAlarms[0], nil
 }
+
+func FindAnomalyDetector(ctx context.Context, conn *cloudwatch.CloudWatch, single *cloudwatch.SingleMetricAnomalyDetector, math *cloudwatch.MetricMathAnomalyDetector) (*cloudwatch.AnomalyDetector, error) {
+	id, err := anomalyDetectorCreateResourceID(single, math)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return FindAnomalyDetectorByID(ctx, conn, id)
+}
+
+func FindAnomalyDetectorByID(ctx context.Context, conn *cloudwatch.CloudWatch, id string) (*cloudwatch.AnomalyDetector, error) {
+	single, err := anomalyDetectorParseResourceID(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &cloudwatch.DescribeAnomalyDetectorsInput{}
+
+	if single != nil {
+		input.AnomalyDetectorTypes = aws.StringSlice([]string{cloudwatch.AnomalyDetectorTypeSingleMetric})
+		input.Dimensions = single.Dimensions
+		input.MetricName = single.MetricName
+		input.Namespace = single.Namespace
+	} else {
+		input.AnomalyDetectorTypes = aws.StringSlice([]string{cloudwatch.AnomalyDetectorTypeMetricMath})
+	}
+
+	for {
+		output, err := conn.DescribeAnomalyDetectorsWithContext(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			break
+		}
+
+		for _, v := range output.AnomalyDetectors {
+			if v == nil {
+				continue
+			}
+
+			if vID, err := anomalyDetectorCreateResourceID(v.SingleMetricAnomalyDetector, v.MetricMathAnomalyDetector); err == nil && vID == id {
+				return v, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func FindInsightRuleByName(ctx context.Context, conn *cloudwatch.CloudWatch, name string) (*cloudwatch.InsightRule, error) {
+	input := &cloudwatch.DescribeInsightRulesInput{}
+	var output *cloudwatch.InsightRule
+
+	err := conn.DescribeInsightRulesPagesWithContext(ctx, input, func(page *cloudwatch.DescribeInsightRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.InsightRules {
+			if aws.StringValue(v.Name) == name {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
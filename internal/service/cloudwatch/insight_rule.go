@@ -0,0 +1,206 @@
+package cloudwatch
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceInsightRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInsightRuleCreate,
+		ReadWithoutTimeout:   resourceInsightRuleRead,
+		UpdateWithoutTimeout: resourceInsightRuleUpdate,
+		DeleteWithoutTimeout: resourceInsightRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[\x20-\x7E]+$`), "must contain only printable ASCII characters"),
+				),
+			},
+			"rule_definition": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"rule_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      insightRuleStateEnabled,
+				ValidateFunc: validation.StringInSlice(insightRuleState_Values(), false),
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceInsightRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudWatchConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &cloudwatch.PutInsightRuleInput{
+		RuleDefinition: aws.String(d.Get("rule_definition").(string)),
+		RuleName:       aws.String(name),
+		RuleState:      aws.String(d.Get("rule_state").(string)),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating CloudWatch Contributor Insights Rule: %s", input)
+	_, err := conn.PutInsightRuleWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating CloudWatch Contributor Insights Rule (%s): %w", name, err))
+	}
+
+	d.SetId(name)
+
+	return resourceInsightRuleRead(ctx, d, meta)
+}
+
+func resourceInsightRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudWatchConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	rule, err := FindInsightRuleByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Contributor Insights Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading CloudWatch Contributor Insights Rule (%s): %w", d.Id(), err))
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   cloudwatch.ServiceName,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("insight-rule/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("name", rule.Name)
+	d.Set("rule_definition", rule.Definition)
+	d.Set("rule_state", rule.State)
+	d.Set("schema", rule.Schema)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing tags for CloudWatch Contributor Insights Rule (%s): %w", arn, err))
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
+	}
+
+	return nil
+}
+
+func resourceInsightRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudWatchConn
+
+	if d.HasChanges("rule_definition", "rule_state") {
+		// Tags specified when updating an existing rule are ignored.
+		input := &cloudwatch.PutInsightRuleInput{
+			RuleDefinition: aws.String(d.Get("rule_definition").(string)),
+			RuleName:       aws.String(d.Id()),
+			RuleState:      aws.String(d.Get("rule_state").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating CloudWatch Contributor Insights Rule: %s", input)
+		_, err := conn.PutInsightRuleWithContext(ctx, input)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating CloudWatch Contributor Insights Rule (%s): %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating CloudWatch Contributor Insights Rule (%s) tags: %w", d.Id(), err))
+		}
+	}
+
+	return resourceInsightRuleRead(ctx, d, meta)
+}
+
+func resourceInsightRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudWatchConn
+
+	log.Printf("[DEBUG] Deleting CloudWatch Contributor Insights Rule: %s", d.Id())
+	output, err := conn.DeleteInsightRulesWithContext(ctx, &cloudwatch.DeleteInsightRulesInput{
+		RuleNames: aws.StringSlice([]string{d.Id()}),
+	})
+
+	if err == nil && output != nil && len(output.Failures) > 0 {
+		failure := output.Failures[0]
+
+		if aws.StringValue(failure.ExceptionType) == cloudwatch.ErrCodeResourceNotFoundException {
+			return nil
+		}
+
+		err = fmt.Errorf("%s: %s", aws.StringValue(failure.FailureCode), aws.StringValue(failure.FailureDescription))
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting CloudWatch Contributor Insights Rule (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
@@ -0,0 +1,227 @@
+package cloudwatch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudwatch "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCloudWatchInsightRule_basic(t *testing.T) {
+	var rule cloudwatch.InsightRule
+	resourceName := "aws_cloudwatch_insight_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInsightRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInsightRuleConfig_basic(rName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInsightRuleExists(resourceName, &rule),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "cloudwatch", fmt.Sprintf("insight-rule/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "rule_definition"),
+					resource.TestCheckResourceAttr(resourceName, "rule_state", "ENABLED"),
+					resource.TestCheckResourceAttrSet(resourceName, "schema"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInsightRuleConfig_basic(rName, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInsightRuleExists(resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "rule_state", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchInsightRule_disappears(t *testing.T) {
+	var rule cloudwatch.InsightRule
+	resourceName := "aws_cloudwatch_insight_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInsightRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInsightRuleConfig_basic(rName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInsightRuleExists(resourceName, &rule),
+					acctest.CheckResourceDisappears(acctest.Provider, tfcloudwatch.ResourceInsightRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchInsightRule_tags(t *testing.T) {
+	var rule cloudwatch.InsightRule
+	resourceName := "aws_cloudwatch_insight_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudwatch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInsightRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInsightRuleConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInsightRuleExists(resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInsightRuleConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInsightRuleExists(resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccInsightRuleConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInsightRuleExists(resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInsightRuleExists(n string, v *cloudwatch.InsightRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudWatch Contributor Insights Rule ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchConn
+
+		output, err := tfcloudwatch.FindInsightRuleByName(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckInsightRuleDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_insight_rule" {
+			continue
+		}
+
+		_, err := tfcloudwatch.FindInsightRuleByName(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudWatch Contributor Insights Rule %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccInsightRuleDefinition(rName string) string {
+	return fmt.Sprintf(`
+  rule_definition = jsonencode({
+    AggregateOn   = "Count"
+    LogFormat     = "JSON"
+    LogGroupNames = [%[1]q]
+
+    Contribution = {
+      Filters = []
+      Keys    = ["$.ip"]
+    }
+
+    Schema = {
+      Name    = "CloudWatchLogRule"
+      Version = 1
+    }
+  })
+`, rName)
+}
+
+func testAccInsightRuleConfig_basic(rName, state string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_insight_rule" "test" {
+  name       = %[1]q
+  rule_state = %[2]q
+%[3]s
+}
+`, rName, state, testAccInsightRuleDefinition(rName))
+}
+
+func testAccInsightRuleConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_insight_rule" "test" {
+  name = %[1]q
+%[2]s
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, testAccInsightRuleDefinition(rName), tagKey1, tagValue1)
+}
+
+func testAccInsightRuleConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_insight_rule" "test" {
+  name = %[1]q
+%[2]s
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, testAccInsightRuleDefinition(rName), tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -1407,7 +1407,12 @@ func resourceProjectUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("concurrent_build_limit") {
-		params.ConcurrentBuildLimit = aws.Int64(int64(d.Get("concurrent_build_limit").(int)))
+		// -1 removes the limit.
+		if v := d.Get("concurrent_build_limit").(int); v > 0 {
+			params.ConcurrentBuildLimit = aws.Int64(int64(v))
+		} else {
+			params.ConcurrentBuildLimit = aws.Int64(-1)
+		}
 	}
 
 	if d.HasChange("description") {
@@ -2303,6 +2303,13 @@ func TestAccCodeBuildProject_concurrentBuildLimit(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "concurrent_build_limit", "12"),
 				),
 			},
+			{
+				Config: testAccProjectConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectExists(resourceName, &project),
+					resource.TestCheckResourceAttr(resourceName, "concurrent_build_limit", "0"),
+				),
+			},
 		},
 	})
 }
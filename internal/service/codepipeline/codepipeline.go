@@ -1,6 +1,7 @@
 package codepipeline
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
@@ -12,7 +13,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/codepipeline"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -178,7 +181,10 @@ func ResourceCodePipeline() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceCodePipelineCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -608,6 +614,40 @@ func resourceCodePipelineDelete(d *schema.ResourceData, meta interface{}) error
 	return err
 }
 
+func resourceCodePipelineCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	var errs *multierror.Error
+
+	for si, v := range diff.Get("stage").([]interface{}) {
+		stage, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for ai, v := range stage["action"].([]interface{}) {
+			action, ok := v.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			// Configuration can only be validated once the action type and its configuration keys are known.
+			addr := fmt.Sprintf("stage.%d.action.%d", si, ai)
+			if !diff.NewValueKnown(addr+".category") || !diff.NewValueKnown(addr+".owner") || !diff.NewValueKnown(addr+".provider") || !diff.NewValueKnown(addr+".configuration") {
+				continue
+			}
+
+			configuration, _ := action["configuration"].(map[string]interface{})
+
+			for _, err := range validateActionConfiguration(action["category"].(string), action["owner"].(string), action["provider"].(string), configuration) {
+				errs = multierror.Append(errs, fmt.Errorf("%s.configuration: %w", addr, err))
+			}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
 func resourceValidateActionProvider(i interface{}, path cty.Path) diag.Diagnostics {
 	v, ok := i.(string)
 	if !ok {
@@ -0,0 +1,52 @@
+package codepipeline_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/aws/aws-sdk-go/service/codestarconnections"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCodePipelineDataSource_basic(t *testing.T) {
+	name := sdkacctest.RandString(10)
+	dataSourceName := "data.aws_codepipeline.test"
+	resourceName := "aws_codepipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckSupported(t)
+			acctest.PreCheckPartitionHasService(codestarconnections.EndpointsID, t)
+		},
+		ErrorCheck: acctest.ErrorCheck(t, codepipeline.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodePipelineDataSourceConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "role_arn", resourceName, "role_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "artifact_store.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "artifact_store.0.location", resourceName, "artifact_store.0.location"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stage.#", resourceName, "stage.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stage.0.name", resourceName, "stage.0.name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stage.0.action.0.provider", resourceName, "stage.0.action.0.provider"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stage.1.action.0.configuration.%", resourceName, "stage.1.action.0.configuration.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCodePipelineDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccConfig_basic(rName), `
+data "aws_codepipeline" "test" {
+  name = aws_codepipeline.test.name
+}
+`)
+}
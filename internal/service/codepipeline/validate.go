@@ -0,0 +1,137 @@
+package codepipeline
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/codepipeline"
+)
+
+// actionConfigurationKeys describes the configuration keys accepted by an AWS-owned action provider.
+type actionConfigurationKeys struct {
+	required []string
+	optional []string
+}
+
+// awsActionConfigurationKeys lists the configuration keys of the AWS-owned action providers,
+// keyed by category and provider.
+// See https://docs.aws.amazon.com/codepipeline/latest/userguide/action-reference.html.
+var awsActionConfigurationKeys = map[string]actionConfigurationKeys{
+	"Approval/Manual": {
+		optional: []string{"CustomData", "ExternalEntityLink", "NotificationArn"},
+	},
+	"Build/CodeBuild": {
+		required: []string{"ProjectName"},
+		optional: []string{"BatchEnabled", "CombineArtifacts", "EnvironmentVariables", "PrimarySource"},
+	},
+	"Deploy/CloudFormation": {
+		required: []string{"ActionMode", "StackName"},
+		optional: []string{"Capabilities", "ChangeSetName", "OutputFileName", "ParameterOverrides", "RoleArn", "TemplateConfiguration", "TemplatePath"},
+	},
+	"Deploy/CodeDeploy": {
+		required: []string{"ApplicationName", "DeploymentGroupName"},
+	},
+	"Deploy/CodeDeployToECS": {
+		required: []string{"AppSpecTemplateArtifact", "ApplicationName", "DeploymentGroupName", "TaskDefinitionTemplateArtifact"},
+		optional: []string{
+			"AppSpecTemplatePath",
+			"Image1ArtifactName", "Image1ContainerName",
+			"Image2ArtifactName", "Image2ContainerName",
+			"Image3ArtifactName", "Image3ContainerName",
+			"Image4ArtifactName", "Image4ContainerName",
+			"TaskDefinitionTemplatePath",
+		},
+	},
+	"Deploy/ECS": {
+		required: []string{"ClusterName", "ServiceName"},
+		optional: []string{"DeploymentTimeout", "FileName"},
+	},
+	"Deploy/ElasticBeanstalk": {
+		required: []string{"ApplicationName", "EnvironmentName"},
+	},
+	"Deploy/S3": {
+		required: []string{"BucketName", "Extract"},
+		optional: []string{"CacheControl", "CannedACL", "KMSEncryptionKeyARN", "ObjectKey"},
+	},
+	"Invoke/Lambda": {
+		required: []string{"FunctionName"},
+		optional: []string{"UserParameters"},
+	},
+	"Invoke/StepFunctions": {
+		required: []string{"StateMachineArn"},
+		optional: []string{"ExecutionNamePrefix", "Input", "InputType"},
+	},
+	"Source/CodeCommit": {
+		required: []string{"BranchName", "RepositoryName"},
+		optional: []string{"OutputArtifactFormat", "PollForSourceChanges"},
+	},
+	"Source/CodeStarSourceConnection": {
+		required: []string{"BranchName", "ConnectionArn", "FullRepositoryId"},
+		optional: []string{"DetectChanges", "OutputArtifactFormat"},
+	},
+	"Source/ECR": {
+		required: []string{"RepositoryName"},
+		optional: []string{"ImageTag"},
+	},
+	"Source/S3": {
+		required: []string{"S3Bucket", "S3ObjectKey"},
+		optional: []string{"AllowOverrideForS3ObjectKey", "PollForSourceChanges"},
+	},
+	"Test/CodeBuild": {
+		required: []string{"ProjectName"},
+		optional: []string{"BatchEnabled", "CombineArtifacts", "EnvironmentVariables", "PrimarySource"},
+	},
+}
+
+// validateActionConfiguration checks the configuration keys of an action against those accepted by its action provider.
+// Only AWS-owned action providers are checked; custom and third-party providers define their own configuration.
+func validateActionConfiguration(category, owner, provider string, configuration map[string]interface{}) []error {
+	if owner != codepipeline.ActionOwnerAws {
+		return nil
+	}
+
+	keys, ok := awsActionConfigurationKeys[category+"/"+provider]
+
+	if !ok {
+		return nil
+	}
+
+	var errs []error
+
+	for _, k := range keys.required {
+		if _, ok := configuration[k]; !ok {
+			errs = append(errs, fmt.Errorf("missing required configuration key %q for %s action provider %q", k, category, provider))
+		}
+	}
+
+	allowed := make(map[string]bool, len(keys.required)+len(keys.optional))
+	for _, k := range keys.required {
+		allowed[k] = true
+	}
+	for _, k := range keys.optional {
+		allowed[k] = true
+	}
+
+	var unsupported []string
+	for k := range configuration {
+		if !allowed[k] {
+			unsupported = append(unsupported, k)
+		}
+	}
+	sort.Strings(unsupported)
+
+	if len(unsupported) > 0 {
+		supported := make([]string, 0, len(allowed))
+		for k := range allowed {
+			supported = append(supported, k)
+		}
+		sort.Strings(supported)
+
+		for _, k := range unsupported {
+			errs = append(errs, fmt.Errorf("unsupported configuration key %q for %s action provider %q, expected one of [%s]", k, category, provider, strings.Join(supported, ", ")))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,106 @@
+package codepipeline
+
+import (
+	"testing"
+)
+
+func TestValidateActionConfiguration(t *testing.T) {
+	testCases := []struct {
+		name          string
+		category      string
+		owner         string
+		provider      string
+		configuration map[string]interface{}
+		errCount      int
+	}{
+		{
+			name:     "valid",
+			category: "Build",
+			owner:    "AWS",
+			provider: "CodeBuild",
+			configuration: map[string]interface{}{
+				"ProjectName":   "test",
+				"PrimarySource": "source",
+			},
+		},
+		{
+			name:     "missing required key",
+			category: "Source",
+			owner:    "AWS",
+			provider: "CodeStarSourceConnection",
+			configuration: map[string]interface{}{
+				"ConnectionArn":    "arn:aws:codestar-connections:us-west-2:123456789012:connection/test", //lintignore:AWSAT003,AWSAT005
+				"FullRepositoryId": "owner/repo",
+			},
+			errCount: 1,
+		},
+		{
+			name:     "unsupported key",
+			category: "Deploy",
+			owner:    "AWS",
+			provider: "S3",
+			configuration: map[string]interface{}{
+				"BucketName": "test",
+				"Extract":    "true",
+				"S3Bucket":   "test",
+			},
+			errCount: 1,
+		},
+		{
+			name:          "no configuration",
+			category:      "Invoke",
+			owner:         "AWS",
+			provider:      "Lambda",
+			configuration: nil,
+			errCount:      1,
+		},
+		{
+			name:          "no required keys",
+			category:      "Approval",
+			owner:         "AWS",
+			provider:      "Manual",
+			configuration: nil,
+		},
+		{
+			name:     "same provider in another category",
+			category: "Source",
+			owner:    "AWS",
+			provider: "S3",
+			configuration: map[string]interface{}{
+				"BucketName": "test",
+				"Extract":    "true",
+			},
+			errCount: 4,
+		},
+		{
+			name:     "third-party provider",
+			category: "Source",
+			owner:    "ThirdParty",
+			provider: "GitHub",
+			configuration: map[string]interface{}{
+				"Owner":  "test",
+				"Repo":   "test",
+				"Branch": "main",
+			},
+		},
+		{
+			name:     "unknown AWS provider",
+			category: "Deploy",
+			owner:    "AWS",
+			provider: "ServiceCatalog",
+			configuration: map[string]interface{}{
+				"ProductId": "prod-test",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			errs := validateActionConfiguration(testCase.category, testCase.owner, testCase.provider, testCase.configuration)
+
+			if got, want := len(errs), testCase.errCount; got != want {
+				t.Errorf("got %d errors, expected %d: %v", got, want, errs)
+			}
+		})
+	}
+}
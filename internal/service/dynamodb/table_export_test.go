@@ -0,0 +1,203 @@
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+)
+
+func TestAccDynamoDBTableExport_basic(t *testing.T) {
+	var export dynamodb.ExportDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_export.test"
+	s3BucketResourceName := "aws_s3_bucket.test"
+	tableResourceName := "aws_dynamodb_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableExportConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableExportExists(resourceName, &export),
+					resource.TestCheckResourceAttrPair(resourceName, "arn", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "export_format", dynamodb.ExportFormatDynamodbJson),
+					resource.TestCheckResourceAttr(resourceName, "export_status", dynamodb.ExportStatusCompleted),
+					resource.TestCheckResourceAttr(resourceName, "item_count", "0"),
+					resource.TestMatchResourceAttr(resourceName, "manifest_files_s3_key", regexp.MustCompile(`AWSDynamoDB/.+/manifest-files.json$`)),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_bucket", s3BucketResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "s3_sse_algorithm", dynamodb.S3SseAlgorithmAes256),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "end_time"),
+					resource.TestCheckResourceAttrPair(resourceName, "table_arn", tableResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableExport_sse(t *testing.T) {
+	var export dynamodb.ExportDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_export.test"
+	kmsKeyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableExportConfig_sse(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableExportExists(resourceName, &export),
+					resource.TestCheckResourceAttr(resourceName, "export_format", dynamodb.ExportFormatIon),
+					resource.TestCheckResourceAttr(resourceName, "s3_prefix", "exports"),
+					resource.TestCheckResourceAttr(resourceName, "s3_sse_algorithm", dynamodb.S3SseAlgorithmKms),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_sse_kms_key_id", kmsKeyResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableExport_exportTimeOffset(t *testing.T) {
+	var export dynamodb.ExportDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_export.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableExportConfig_exportTimeOffset(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableExportExists(resourceName, &export),
+					resource.TestCheckResourceAttrPair(resourceName, "export_time", "aws_dynamodb_table_export.initial", "export_time"),
+				),
+			},
+			{
+				Config:   testAccTableExportConfig_exportTimeOffset(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTableExportExists(n string, v *dynamodb.ExportDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No DynamoDB Table Export ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+
+		output, err := tfdynamodb.FindTableExportByARN(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTableExportBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_dynamodb_table" "test" {
+  name           = %[1]q
+  read_capacity  = 2
+  write_capacity = 2
+  hash_key       = "TestTableHashKey"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  point_in_time_recovery {
+    enabled = true
+  }
+}
+`, rName)
+}
+
+func testAccTableExportConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTableExportBaseConfig(rName), `
+resource "aws_dynamodb_table_export" "test" {
+  s3_bucket = aws_s3_bucket.test.id
+  table_arn = aws_dynamodb_table.test.arn
+}
+`)
+}
+
+func testAccTableExportConfig_sse(rName string) string {
+	return acctest.ConfigCompose(testAccTableExportBaseConfig(rName), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_dynamodb_table_export" "test" {
+  export_format     = "ION"
+  s3_bucket         = aws_s3_bucket.test.id
+  s3_prefix         = "exports"
+  s3_sse_algorithm  = "KMS"
+  s3_sse_kms_key_id = aws_kms_key.test.id
+  table_arn         = aws_dynamodb_table.test.arn
+}
+`, rName))
+}
+
+func testAccTableExportConfig_exportTimeOffset(rName string) string {
+	return acctest.ConfigCompose(testAccTableExportBaseConfig(rName), `
+resource "aws_dynamodb_table_export" "initial" {
+  s3_bucket = aws_s3_bucket.test.id
+  s3_prefix = "initial"
+  table_arn = aws_dynamodb_table.test.arn
+}
+
+# The same instant as the initial export, expressed with a +02:00 offset.
+resource "aws_dynamodb_table_export" "test" {
+  export_time = replace(timeadd(aws_dynamodb_table_export.initial.export_time, "2h"), "Z", "+02:00")
+  s3_bucket   = aws_s3_bucket.test.id
+  s3_prefix   = "offset"
+  table_arn   = aws_dynamodb_table.test.arn
+}
+`)
+}
@@ -0,0 +1,474 @@
+package dynamodb
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// BatchWriteItem accepts at most 25 put or delete requests.
+	tableItemsBatchWriteMaxSize = 25
+	// BatchGetItem accepts at most 100 keys.
+	tableItemsBatchGetMaxSize = 100
+
+	tableItemsBatchTimeout = 5 * time.Minute
+)
+
+func ResourceTableItems() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTableItemsCreate,
+		Read:   resourceTableItemsRead,
+		Update: resourceTableItemsUpdate,
+		Delete: resourceTableItemsDelete,
+
+		CustomizeDiff: customdiff.ComputedIf("item_hashes", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+			return d.HasChange("items")
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"hash_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"item_hashes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"items": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateDynamoDbTableItem,
+					// Only a hash of each item is stored in state.
+					StateFunc: tableItemsStateFunc,
+				},
+			},
+			"range_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"table_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceTableItemsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName := d.Get("table_name").(string)
+	items, hashes, err := expandTableItems(d.Get("items").([]interface{}), d.Get("hash_key").(string), d.Get("range_key").(string))
+
+	if err != nil {
+		return err
+	}
+
+	var requests []*dynamodb.WriteRequest
+
+	for _, item := range items {
+		requests = append(requests, &dynamodb.WriteRequest{
+			PutRequest: &dynamodb.PutRequest{
+				Item: item,
+			},
+		})
+	}
+
+	log.Printf("[DEBUG] Creating DynamoDB Table (%s) Items: %d items", tableName, len(requests))
+	if err := batchWriteTableItems(conn, tableName, requests); err != nil {
+		return fmt.Errorf("error creating DynamoDB Table (%s) Items: %w", tableName, err)
+	}
+
+	d.SetId(tableName)
+	d.Set("item_hashes", hashes)
+
+	return resourceTableItemsRead(d, meta)
+}
+
+func resourceTableItemsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName := d.Get("table_name").(string)
+	hashKey := d.Get("hash_key").(string)
+	rangeKey := d.Get("range_key").(string)
+
+	oldHashes := map[string]string{}
+	var keys []map[string]*dynamodb.AttributeValue
+
+	for k, v := range d.Get("item_hashes").(map[string]interface{}) {
+		key, err := ExpandTableItemAttributes(k)
+
+		if err != nil {
+			return fmt.Errorf("error reading DynamoDB Table (%s) Items: %w", tableName, err)
+		}
+
+		oldHashes[k] = v.(string)
+		keys = append(keys, key)
+	}
+
+	remoteItems, err := batchGetTableItems(conn, tableName, keys)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] DynamoDB Table (%s) not found, removing Items from state", tableName)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DynamoDB Table (%s) Items: %w", tableName, err)
+	}
+
+	newHashes := map[string]string{}
+
+	for _, item := range remoteItems {
+		k, err := tableItemKey(item, hashKey, rangeKey)
+
+		if err != nil {
+			return fmt.Errorf("error reading DynamoDB Table (%s) Items: %w", tableName, err)
+		}
+
+		hash, err := tableItemHash(item)
+
+		if err != nil {
+			return fmt.Errorf("error reading DynamoDB Table (%s) Items: %w", tableName, err)
+		}
+
+		newHashes[k] = hash
+	}
+
+	// Rebuild the list of item hashes in configuration order so that
+	// items that were changed or deleted outside Terraform show up as a difference.
+	keysByHash := map[string]string{}
+	for k, v := range oldHashes {
+		keysByHash[v] = k
+	}
+
+	var itemsHashes []string
+
+	for _, v := range d.Get("items").([]interface{}) {
+		// Immediately after create or update the configured items are returned rather than their hashes.
+		hash := tableItemsStateFunc(v)
+		k, ok := keysByHash[hash]
+
+		if !ok {
+			itemsHashes = append(itemsHashes, hash)
+			continue
+		}
+
+		if hash, ok := newHashes[k]; ok {
+			itemsHashes = append(itemsHashes, hash)
+		}
+	}
+
+	if err := d.Set("item_hashes", newHashes); err != nil {
+		return fmt.Errorf("error setting item_hashes: %w", err)
+	}
+
+	if err := d.Set("items", itemsHashes); err != nil {
+		return fmt.Errorf("error setting items: %w", err)
+	}
+
+	return nil
+}
+
+func resourceTableItemsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	if d.HasChange("items") {
+		tableName := d.Get("table_name").(string)
+		items, hashes, err := expandTableItems(d.Get("items").([]interface{}), d.Get("hash_key").(string), d.Get("range_key").(string))
+
+		if err != nil {
+			return err
+		}
+
+		o, _ := d.GetChange("item_hashes")
+		oldHashes := o.(map[string]interface{})
+
+		var requests []*dynamodb.WriteRequest
+
+		for k, item := range items {
+			if v, ok := oldHashes[k]; ok && v.(string) == hashes[k] {
+				continue
+			}
+
+			requests = append(requests, &dynamodb.WriteRequest{
+				PutRequest: &dynamodb.PutRequest{
+					Item: item,
+				},
+			})
+		}
+
+		for k := range oldHashes {
+			if _, ok := items[k]; ok {
+				continue
+			}
+
+			key, err := ExpandTableItemAttributes(k)
+
+			if err != nil {
+				return err
+			}
+
+			requests = append(requests, &dynamodb.WriteRequest{
+				DeleteRequest: &dynamodb.DeleteRequest{
+					Key: key,
+				},
+			})
+		}
+
+		log.Printf("[DEBUG] Updating DynamoDB Table (%s) Items: %d changes", tableName, len(requests))
+		if err := batchWriteTableItems(conn, tableName, requests); err != nil {
+			return fmt.Errorf("error updating DynamoDB Table (%s) Items: %w", tableName, err)
+		}
+
+		d.Set("item_hashes", hashes)
+	}
+
+	return resourceTableItemsRead(d, meta)
+}
+
+func resourceTableItemsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DynamoDBConn
+
+	tableName := d.Get("table_name").(string)
+
+	var requests []*dynamodb.WriteRequest
+
+	for k := range d.Get("item_hashes").(map[string]interface{}) {
+		key, err := ExpandTableItemAttributes(k)
+
+		if err != nil {
+			return err
+		}
+
+		requests = append(requests, &dynamodb.WriteRequest{
+			DeleteRequest: &dynamodb.DeleteRequest{
+				Key: key,
+			},
+		})
+	}
+
+	log.Printf("[DEBUG] Deleting DynamoDB Table (%s) Items: %d items", tableName, len(requests))
+	err := batchWriteTableItems(conn, tableName, requests)
+
+	if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting DynamoDB Table (%s) Items: %w", tableName, err)
+	}
+
+	return nil
+}
+
+func tableItemsStateFunc(v interface{}) string {
+	attributes, err := ExpandTableItemAttributes(v.(string))
+
+	if err != nil {
+		return v.(string)
+	}
+
+	hash, err := tableItemHash(attributes)
+
+	if err != nil {
+		return v.(string)
+	}
+
+	return hash
+}
+
+// expandTableItems returns the configured items and their hashes, both keyed by the item's primary key.
+func expandTableItems(tfList []interface{}, hashKey, rangeKey string) (map[string]map[string]*dynamodb.AttributeValue, map[string]string, error) {
+	items := map[string]map[string]*dynamodb.AttributeValue{}
+	hashes := map[string]string{}
+
+	for i, v := range tfList {
+		item, err := ExpandTableItemAttributes(v.(string))
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("items.%d: %w", i, err)
+		}
+
+		if _, ok := item[hashKey]; !ok {
+			return nil, nil, fmt.Errorf("items.%d: missing hash key (%s)", i, hashKey)
+		}
+
+		if _, ok := item[rangeKey]; rangeKey != "" && !ok {
+			return nil, nil, fmt.Errorf("items.%d: missing range key (%s)", i, rangeKey)
+		}
+
+		k, err := tableItemKey(item, hashKey, rangeKey)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("items.%d: %w", i, err)
+		}
+
+		if _, ok := items[k]; ok {
+			return nil, nil, fmt.Errorf("items.%d: duplicate primary key %s", i, k)
+		}
+
+		hash, err := tableItemHash(item)
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("items.%d: %w", i, err)
+		}
+
+		items[k] = item
+		hashes[k] = hash
+	}
+
+	return items, hashes, nil
+}
+
+// tableItemKey returns the item's primary key attributes, serialized as JSON.
+func tableItemKey(item map[string]*dynamodb.AttributeValue, hashKey, rangeKey string) (string, error) {
+	v, err := flattenDynamoDBTableItemAttributes(BuildTableItemqueryKey(item, hashKey, rangeKey))
+
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(v), nil
+}
+
+// tableItemHash returns the hex-encoded SHA256 hash of the item's normalized JSON representation.
+func tableItemHash(item map[string]*dynamodb.AttributeValue) (string, error) {
+	v, err := flattenDynamoDBTableItemAttributes(item)
+
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(v))
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// batchWriteTableItems applies the write requests in batches, retrying any unprocessed items.
+func batchWriteTableItems(conn *dynamodb.DynamoDB, tableName string, requests []*dynamodb.WriteRequest) error {
+	for len(requests) > 0 {
+		n := tableItemsBatchWriteMaxSize
+		if len(requests) < n {
+			n = len(requests)
+		}
+
+		pending := requests[:n]
+		requests = requests[n:]
+
+		err := resource.Retry(tableItemsBatchTimeout, func() *resource.RetryError {
+			output, err := conn.BatchWriteItem(&dynamodb.BatchWriteItemInput{
+				RequestItems: map[string][]*dynamodb.WriteRequest{
+					tableName: pending,
+				},
+			})
+
+			if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeProvisionedThroughputExceededException, dynamodb.ErrCodeRequestLimitExceeded) {
+				return resource.RetryableError(err)
+			}
+
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			if output != nil && len(output.UnprocessedItems[tableName]) > 0 {
+				pending = output.UnprocessedItems[tableName]
+
+				return resource.RetryableError(fmt.Errorf("%d unprocessed items", len(pending)))
+			}
+
+			return nil
+		})
+
+		if tfresource.TimedOut(err) {
+			return fmt.Errorf("%d unprocessed items: %w", len(pending), err)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// batchGetTableItems returns the items with the specified keys, retrying any unprocessed keys.
+// Items that do not exist are omitted.
+func batchGetTableItems(conn *dynamodb.DynamoDB, tableName string, keys []map[string]*dynamodb.AttributeValue) ([]map[string]*dynamodb.AttributeValue, error) {
+	var items []map[string]*dynamodb.AttributeValue
+
+	for len(keys) > 0 {
+		n := tableItemsBatchGetMaxSize
+		if len(keys) < n {
+			n = len(keys)
+		}
+
+		pending := keys[:n]
+		keys = keys[n:]
+
+		err := resource.Retry(tableItemsBatchTimeout, func() *resource.RetryError {
+			output, err := conn.BatchGetItem(&dynamodb.BatchGetItemInput{
+				RequestItems: map[string]*dynamodb.KeysAndAttributes{
+					tableName: {
+						ConsistentRead: aws.Bool(true),
+						Keys:           pending,
+					},
+				},
+			})
+
+			if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeProvisionedThroughputExceededException, dynamodb.ErrCodeRequestLimitExceeded) {
+				return resource.RetryableError(err)
+			}
+
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			if output == nil {
+				return nil
+			}
+
+			items = append(items, output.Responses[tableName]...)
+
+			if v, ok := output.UnprocessedKeys[tableName]; ok && v != nil && len(v.Keys) > 0 {
+				pending = v.Keys
+
+				return resource.RetryableError(fmt.Errorf("%d unprocessed keys", len(pending)))
+			}
+
+			return nil
+		})
+
+		if tfresource.TimedOut(err) {
+			return nil, fmt.Errorf("%d unprocessed keys: %w", len(pending), err)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return items, nil
+}
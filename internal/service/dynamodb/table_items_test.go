@@ -0,0 +1,335 @@
+package dynamodb_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccDynamoDBTableItems_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTableItemsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "hash_key", "id"),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "3"),
+					resource.TestMatchResourceAttr(resourceName, "items.0", regexp.MustCompile(`^[0-9a-f]{64}$`)),
+					resource.TestCheckResourceAttr(resourceName, "table_name", rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTableItemsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "3"),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(rName, 3),
+					testAccCheckTableItemsAttribute(rName, "id", "1", "value", "updated"),
+					testAccCheckTableItemsAttribute(rName, "id", "4", "value", "new"),
+					testAccCheckTableItemsNotExists(rName, "id", "3"),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+// Exercises batching and retrying of BatchWriteItem and BatchGetItem requests.
+func TestAccDynamoDBTableItems_manyItems(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTableItemsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName, 260),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(rName, 260),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "260"),
+				),
+			},
+			{
+				Config: testAccTableItemsConfig_basic(rName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(rName, 30),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "30"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_rangeKey(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTableItemsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_rangeKey(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(rName, 4),
+					resource.TestCheckResourceAttr(resourceName, "item_hashes.%", "4"),
+					resource.TestCheckResourceAttr(resourceName, "range_key", "sk"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_itemDeletedOutsideTerraform(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dynamodb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTableItemsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemsDeleteItem(rName, "id", "2"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccTableItemsConfig_basic(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTableItemCount(rName, 3),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTableItemsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dynamodb_table_items" {
+			continue
+		}
+
+		output, err := conn.Scan(&dynamodb.ScanInput{
+			ConsistentRead: aws.Bool(true),
+			Select:         aws.String(dynamodb.SelectCount),
+			TableName:      aws.String(rs.Primary.Attributes["table_name"]),
+		})
+
+		if tfawserr.ErrCodeEquals(err, dynamodb.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if n := aws.Int64Value(output.Count); n != 0 {
+			return fmt.Errorf("DynamoDB Table (%s) still has %d items", rs.Primary.ID, n)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckTableItemsAttribute(tableName, hashKey, hashValue, name, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+
+		output, err := conn.GetItem(&dynamodb.GetItemInput{
+			ConsistentRead: aws.Bool(true),
+			Key: map[string]*dynamodb.AttributeValue{
+				hashKey: {S: aws.String(hashValue)},
+			},
+			TableName: aws.String(tableName),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output.Item == nil {
+			return fmt.Errorf("DynamoDB Table (%s) item (%s) not found", tableName, hashValue)
+		}
+
+		if got := aws.StringValue(output.Item[name].S); got != value {
+			return fmt.Errorf("DynamoDB Table (%s) item (%s) attribute %s: got %q, expected %q", tableName, hashValue, name, got, value)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTableItemsNotExists(tableName, hashKey, hashValue string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+
+		output, err := conn.GetItem(&dynamodb.GetItemInput{
+			ConsistentRead: aws.Bool(true),
+			Key: map[string]*dynamodb.AttributeValue{
+				hashKey: {S: aws.String(hashValue)},
+			},
+			TableName: aws.String(tableName),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output.Item != nil {
+			return fmt.Errorf("DynamoDB Table (%s) item (%s) still exists", tableName, hashValue)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTableItemsDeleteItem(tableName, hashKey, hashValue string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBConn
+
+		_, err := conn.DeleteItem(&dynamodb.DeleteItemInput{
+			Key: map[string]*dynamodb.AttributeValue{
+				hashKey: {S: aws.String(hashValue)},
+			},
+			TableName: aws.String(tableName),
+		})
+
+		return err
+	}
+}
+
+func testAccTableItemsBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+}
+`, rName)
+}
+
+func testAccTableItemsConfig_basic(rName string, count int) string {
+	return acctest.ConfigCompose(testAccTableItemsBaseConfig(rName), fmt.Sprintf(`
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  items = [for i in range(%[1]d) : jsonencode({
+    id    = { S = tostring(i + 1) }
+    value = { S = "value${i + 1}" }
+    count = { N = tostring(i) }
+  })]
+}
+`, count))
+}
+
+func testAccTableItemsConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccTableItemsBaseConfig(rName), `
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+
+  items = [
+    jsonencode({
+      id    = { S = "1" }
+      value = { S = "updated" }
+    }),
+    jsonencode({
+      id    = { S = "2" }
+      value = { S = "value2" }
+      count = { N = "1" }
+    }),
+    jsonencode({
+      id    = { S = "4" }
+      value = { S = "new" }
+    }),
+  ]
+}
+`)
+}
+
+func testAccTableItemsConfig_rangeKey(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "pk"
+  range_key    = "sk"
+
+  attribute {
+    name = "pk"
+    type = "S"
+  }
+
+  attribute {
+    name = "sk"
+    type = "N"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+
+  items = [for pair in setproduct(["a", "b"], [1, 2]) : jsonencode({
+    pk    = { S = pair[0] }
+    sk    = { N = tostring(pair[1]) }
+    value = { S = "${pair[0]}${pair[1]}" }
+  })]
+}
+`, rName)
+}
@@ -48,7 +48,9 @@ const (
 )
 
 const (
-	ErrCodeInvalidTransitGatewayIDNotFound = "InvalidTransitGatewayID.NotFound"
+	ErrCodeInvalidTransitGatewayConnectPeerIDNotFound     = "InvalidTransitGatewayConnectPeerID.NotFound"
+	ErrCodeInvalidTransitGatewayIDNotFound                = "InvalidTransitGatewayID.NotFound"
+	ErrCodeInvalidTransitGatewayMulticastDomainIDNotFound = "InvalidTransitGatewayMulticastDomainId.NotFound"
 )
 
 const (
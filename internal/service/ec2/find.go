@@ -154,6 +154,47 @@ func FindInstanceByID(conn *ec2.EC2, id string) (*ec2.Instance, error) {
 	return output.Reservations[0].Instances[0], nil
 }
 
+// FindInstanceStateByID looks up the state of an Instance by ID.
+// Terminated instances are treated as not found.
+func FindInstanceStateByID(conn *ec2.EC2, id string) (*ec2.InstanceState, error) {
+	input := &ec2.DescribeInstanceStatusInput{
+		InstanceIds:         aws.StringSlice([]string{id}),
+		IncludeAllInstances: aws.Bool(true),
+	}
+
+	output, err := conn.DescribeInstanceStatus(input)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidInstanceIDNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.InstanceStatuses) == 0 || output.InstanceStatuses[0] == nil || output.InstanceStatuses[0].InstanceState == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.InstanceStatuses); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	instanceState := output.InstanceStatuses[0].InstanceState
+
+	if name := aws.StringValue(instanceState.Name); name == ec2.InstanceStateNameTerminated {
+		return nil, &resource.NotFoundError{
+			Message:     name,
+			LastRequest: input,
+		}
+	}
+
+	return instanceState, nil
+}
+
 // FindNetworkACLByID looks up a NetworkAcl by ID. When not found, returns nil and potentially an API error.
 func FindNetworkACLByID(conn *ec2.EC2, id string) (*ec2.NetworkAcl, error) {
 	input := &ec2.DescribeNetworkAclsInput{
@@ -729,6 +770,28 @@ func FindSubnetCidrReservationBySubnetIDAndReservationID(conn *ec2.EC2, subnetID
 	}
 }
 
+// FindTransitGatewayMulticastGroup returns the registration of the network interface with the multicast group
+// selected by filterName, e.g. "is-group-member" or "is-group-source".
+func FindTransitGatewayMulticastGroup(conn *ec2.EC2, multicastDomainID, filterName, groupIPAddress, networkInterfaceID string) (*ec2.TransitGatewayMulticastGroup, error) {
+	output, err := DescribeTransitGatewayMulticastGroup(conn, multicastDomainID, filterName, groupIPAddress, networkInterfaceID)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidTransitGatewayMulticastDomainIDNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(nil)
+	}
+
+	return output, nil
+}
+
 func FindTransitGatewayPrefixListReference(conn *ec2.EC2, transitGatewayRouteTableID string, prefixListID string) (*ec2.TransitGatewayPrefixListReference, error) {
 	filters := map[string]string{
 		"prefix-list-id": prefixListID,
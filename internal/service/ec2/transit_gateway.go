@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -97,6 +98,13 @@ func ResourceTransitGateway() *schema.Resource {
 					ec2.DnsSupportValueEnable,
 				}, false),
 			},
+			"multicast_support": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.MulticastSupportValueDisable,
+				ValidateFunc: validation.StringInSlice(ec2.MulticastSupportValue_Values(), false),
+			},
 			"owner_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -107,6 +115,15 @@ func ResourceTransitGateway() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"transit_gateway_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidCIDRNetworkAddress,
+				},
+			},
 			"vpn_ecmp_support": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -131,11 +148,16 @@ func resourceTransitGatewayCreate(d *schema.ResourceData, meta interface{}) erro
 			DefaultRouteTableAssociation: aws.String(d.Get("default_route_table_association").(string)),
 			DefaultRouteTablePropagation: aws.String(d.Get("default_route_table_propagation").(string)),
 			DnsSupport:                   aws.String(d.Get("dns_support").(string)),
+			MulticastSupport:             aws.String(d.Get("multicast_support").(string)),
 			VpnEcmpSupport:               aws.String(d.Get("vpn_ecmp_support").(string)),
 		},
 		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeTransitGateway),
 	}
 
+	if v, ok := d.GetOk("transit_gateway_cidr_blocks"); ok && v.(*schema.Set).Len() > 0 {
+		input.Options.TransitGatewayCidrBlocks = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("amazon_side_asn"); ok {
 		input.Options.AmazonSideAsn = aws.Int64(int64(v.(int)))
 	}
@@ -200,6 +222,7 @@ func resourceTransitGatewayRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("default_route_table_propagation", transitGateway.Options.DefaultRouteTablePropagation)
 	d.Set("description", transitGateway.Description)
 	d.Set("dns_support", transitGateway.Options.DnsSupport)
+	d.Set("multicast_support", transitGateway.Options.MulticastSupport)
 	d.Set("owner_id", transitGateway.OwnerId)
 	d.Set("propagation_default_route_table_id", transitGateway.Options.PropagationDefaultRouteTableId)
 
@@ -214,6 +237,10 @@ func resourceTransitGatewayRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error setting tags_all: %w", err)
 	}
 
+	if err := d.Set("transit_gateway_cidr_blocks", aws.StringValueSlice(transitGateway.Options.TransitGatewayCidrBlocks)); err != nil {
+		return fmt.Errorf("error setting transit_gateway_cidr_blocks: %s", err)
+	}
+
 	d.Set("vpn_ecmp_support", transitGateway.Options.VpnEcmpSupport)
 
 	return nil
@@ -252,6 +279,22 @@ func resourceTransitGatewayUpdate(d *schema.ResourceData, meta interface{}) erro
 		options.DnsSupport = aws.String(d.Get("dns_support").(string))
 	}
 
+	if d.HasChange("transit_gateway_cidr_blocks") {
+		transitGatewayModified = true
+
+		oldRaw, newRaw := d.GetChange("transit_gateway_cidr_blocks")
+		oldSet := oldRaw.(*schema.Set)
+		newSet := newRaw.(*schema.Set)
+
+		if added := newSet.Difference(oldSet); added.Len() > 0 {
+			options.AddTransitGatewayCidrBlocks = flex.ExpandStringSet(added)
+		}
+
+		if removed := oldSet.Difference(newSet); removed.Len() > 0 {
+			options.RemoveTransitGatewayCidrBlocks = flex.ExpandStringSet(removed)
+		}
+	}
+
 	if d.HasChange("vpn_ecmp_support") {
 		transitGatewayModified = true
 		options.VpnEcmpSupport = aws.String(d.Get("vpn_ecmp_support").(string))
@@ -357,6 +400,26 @@ func DecodeTransitGatewayRouteTablePropagationID(id string) (string, string, err
 	return parts[0], parts[1], nil
 }
 
+func DecodeTransitGatewayMulticastDomainAssociationID(id string) (string, string, string, error) {
+	parts := strings.Split(id, "_")
+
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("Unexpected format of ID (%q), expected tgw-mcast-domain-ID_tgw-attach-ID_subnet-ID", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+func DecodeTransitGatewayMulticastGroupID(id string) (string, string, string, error) {
+	parts := strings.Split(id, "_")
+
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("Unexpected format of ID (%q), expected tgw-mcast-domain-ID_GROUP-IP-ADDRESS_eni-ID", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
 func DescribeTransitGateway(conn *ec2.EC2, transitGatewayID string) (*ec2.TransitGateway, error) {
 	input := &ec2.DescribeTransitGatewaysInput{
 		TransitGatewayIds: []*string{aws.String(transitGatewayID)},
@@ -394,6 +457,201 @@ func DescribeTransitGateway(conn *ec2.EC2, transitGatewayID string) (*ec2.Transi
 	return nil, nil
 }
 
+func DescribeTransitGatewayConnect(conn *ec2.EC2, transitGatewayAttachmentID string) (*ec2.TransitGatewayConnect, error) {
+	input := &ec2.DescribeTransitGatewayConnectsInput{
+		TransitGatewayAttachmentIds: []*string{aws.String(transitGatewayAttachmentID)},
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Transit Gateway Connect (%s): %s", transitGatewayAttachmentID, input)
+	for {
+		output, err := conn.DescribeTransitGatewayConnects(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil || len(output.TransitGatewayConnects) == 0 {
+			return nil, nil
+		}
+
+		for _, transitGatewayConnect := range output.TransitGatewayConnects {
+			if transitGatewayConnect == nil {
+				continue
+			}
+
+			if aws.StringValue(transitGatewayConnect.TransitGatewayAttachmentId) == transitGatewayAttachmentID {
+				return transitGatewayConnect, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+func DescribeTransitGatewayConnectPeer(conn *ec2.EC2, transitGatewayConnectPeerID string) (*ec2.TransitGatewayConnectPeer, error) {
+	input := &ec2.DescribeTransitGatewayConnectPeersInput{
+		TransitGatewayConnectPeerIds: []*string{aws.String(transitGatewayConnectPeerID)},
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Transit Gateway Connect Peer (%s): %s", transitGatewayConnectPeerID, input)
+	for {
+		output, err := conn.DescribeTransitGatewayConnectPeers(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil || len(output.TransitGatewayConnectPeers) == 0 {
+			return nil, nil
+		}
+
+		for _, transitGatewayConnectPeer := range output.TransitGatewayConnectPeers {
+			if transitGatewayConnectPeer == nil {
+				continue
+			}
+
+			if aws.StringValue(transitGatewayConnectPeer.TransitGatewayConnectPeerId) == transitGatewayConnectPeerID {
+				return transitGatewayConnectPeer, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+func DescribeTransitGatewayMulticastDomain(conn *ec2.EC2, transitGatewayMulticastDomainID string) (*ec2.TransitGatewayMulticastDomain, error) {
+	input := &ec2.DescribeTransitGatewayMulticastDomainsInput{
+		TransitGatewayMulticastDomainIds: []*string{aws.String(transitGatewayMulticastDomainID)},
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Transit Gateway Multicast Domain (%s): %s", transitGatewayMulticastDomainID, input)
+	for {
+		output, err := conn.DescribeTransitGatewayMulticastDomains(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil || len(output.TransitGatewayMulticastDomains) == 0 {
+			return nil, nil
+		}
+
+		for _, transitGatewayMulticastDomain := range output.TransitGatewayMulticastDomains {
+			if transitGatewayMulticastDomain == nil {
+				continue
+			}
+
+			if aws.StringValue(transitGatewayMulticastDomain.TransitGatewayMulticastDomainId) == transitGatewayMulticastDomainID {
+				return transitGatewayMulticastDomain, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+func DescribeTransitGatewayMulticastDomainAssociation(conn *ec2.EC2, transitGatewayMulticastDomainID, transitGatewayAttachmentID, subnetID string) (*ec2.TransitGatewayMulticastDomainAssociation, error) {
+	input := &ec2.GetTransitGatewayMulticastDomainAssociationsInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"subnet-id":                     subnetID,
+			"transit-gateway-attachment-id": transitGatewayAttachmentID,
+		}),
+		TransitGatewayMulticastDomainId: aws.String(transitGatewayMulticastDomainID),
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Transit Gateway Multicast Domain (%s) association: %s", transitGatewayMulticastDomainID, input)
+	for {
+		output, err := conn.GetTransitGatewayMulticastDomainAssociations(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil || len(output.MulticastDomainAssociations) == 0 {
+			return nil, nil
+		}
+
+		for _, association := range output.MulticastDomainAssociations {
+			if association == nil || association.Subnet == nil {
+				continue
+			}
+
+			if aws.StringValue(association.TransitGatewayAttachmentId) == transitGatewayAttachmentID && aws.StringValue(association.Subnet.SubnetId) == subnetID {
+				return association, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+// DescribeTransitGatewayMulticastGroup returns the multicast group member or source (depending on filterName)
+// registered in the multicast domain for the specified group IP address and network interface.
+func DescribeTransitGatewayMulticastGroup(conn *ec2.EC2, transitGatewayMulticastDomainID, filterName, groupIPAddress, networkInterfaceID string) (*ec2.TransitGatewayMulticastGroup, error) {
+	input := &ec2.SearchTransitGatewayMulticastGroupsInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"group-ip-address": groupIPAddress,
+			filterName:         "true",
+		}),
+		TransitGatewayMulticastDomainId: aws.String(transitGatewayMulticastDomainID),
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Transit Gateway Multicast Domain (%s) group: %s", transitGatewayMulticastDomainID, input)
+	for {
+		output, err := conn.SearchTransitGatewayMulticastGroups(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil || len(output.MulticastGroups) == 0 {
+			return nil, nil
+		}
+
+		for _, multicastGroup := range output.MulticastGroups {
+			if multicastGroup == nil {
+				continue
+			}
+
+			if aws.StringValue(multicastGroup.GroupIpAddress) == groupIPAddress && aws.StringValue(multicastGroup.NetworkInterfaceId) == networkInterfaceID {
+				return multicastGroup, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
 func DescribeTransitGatewayPeeringAttachment(conn *ec2.EC2, transitGatewayAttachmentID string) (*ec2.TransitGatewayPeeringAttachment, error) {
 	input := &ec2.DescribeTransitGatewayPeeringAttachmentsInput{
 		TransitGatewayAttachmentIds: []*string{aws.String(transitGatewayAttachmentID)},
@@ -574,6 +832,86 @@ func DescribeTransitGatewayVPCAttachment(conn *ec2.EC2, transitGatewayAttachment
 	return nil, nil
 }
 
+func transitGatewayConnectRefreshFunc(conn *ec2.EC2, transitGatewayAttachmentID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		transitGatewayConnect, err := DescribeTransitGatewayConnect(conn, transitGatewayAttachmentID)
+
+		if tfawserr.ErrMessageContains(err, "InvalidTransitGatewayAttachmentID.NotFound", "") {
+			return nil, ec2.TransitGatewayAttachmentStateDeleted, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EC2 Transit Gateway Connect (%s): %s", transitGatewayAttachmentID, err)
+		}
+
+		if transitGatewayConnect == nil {
+			return nil, ec2.TransitGatewayAttachmentStateDeleted, nil
+		}
+
+		return transitGatewayConnect, aws.StringValue(transitGatewayConnect.State), nil
+	}
+}
+
+func transitGatewayConnectPeerRefreshFunc(conn *ec2.EC2, transitGatewayConnectPeerID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		transitGatewayConnectPeer, err := DescribeTransitGatewayConnectPeer(conn, transitGatewayConnectPeerID)
+
+		if tfawserr.ErrMessageContains(err, ErrCodeInvalidTransitGatewayConnectPeerIDNotFound, "") {
+			return nil, ec2.TransitGatewayConnectPeerStateDeleted, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EC2 Transit Gateway Connect Peer (%s): %s", transitGatewayConnectPeerID, err)
+		}
+
+		if transitGatewayConnectPeer == nil {
+			return nil, ec2.TransitGatewayConnectPeerStateDeleted, nil
+		}
+
+		return transitGatewayConnectPeer, aws.StringValue(transitGatewayConnectPeer.State), nil
+	}
+}
+
+func transitGatewayMulticastDomainAssociationRefreshFunc(conn *ec2.EC2, transitGatewayMulticastDomainID, transitGatewayAttachmentID, subnetID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		association, err := DescribeTransitGatewayMulticastDomainAssociation(conn, transitGatewayMulticastDomainID, transitGatewayAttachmentID, subnetID)
+
+		if tfawserr.ErrMessageContains(err, ErrCodeInvalidTransitGatewayMulticastDomainIDNotFound, "") {
+			return nil, ec2.TransitGatewayMulitcastDomainAssociationStateDisassociated, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EC2 Transit Gateway Multicast Domain (%s) association (%s/%s): %s", transitGatewayMulticastDomainID, transitGatewayAttachmentID, subnetID, err)
+		}
+
+		if association == nil {
+			return nil, ec2.TransitGatewayMulitcastDomainAssociationStateDisassociated, nil
+		}
+
+		return association, aws.StringValue(association.Subnet.State), nil
+	}
+}
+
+func transitGatewayMulticastDomainRefreshFunc(conn *ec2.EC2, transitGatewayMulticastDomainID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		transitGatewayMulticastDomain, err := DescribeTransitGatewayMulticastDomain(conn, transitGatewayMulticastDomainID)
+
+		if tfawserr.ErrMessageContains(err, ErrCodeInvalidTransitGatewayMulticastDomainIDNotFound, "") {
+			return nil, ec2.TransitGatewayMulticastDomainStateDeleted, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EC2 Transit Gateway Multicast Domain (%s): %s", transitGatewayMulticastDomainID, err)
+		}
+
+		if transitGatewayMulticastDomain == nil {
+			return nil, ec2.TransitGatewayMulticastDomainStateDeleted, nil
+		}
+
+		return transitGatewayMulticastDomain, aws.StringValue(transitGatewayMulticastDomain.State), nil
+	}
+}
+
 func transitGatewayPeeringAttachmentRefreshFunc(conn *ec2.EC2, transitGatewayAttachmentID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		transitGatewayPeeringAttachment, err := DescribeTransitGatewayPeeringAttachment(conn, transitGatewayAttachmentID)
@@ -780,6 +1118,150 @@ func WaitForTransitGatewayDeletion(conn *ec2.EC2, transitGatewayID string) error
 	return err
 }
 
+func waitForTransitGatewayConnectCreation(conn *ec2.EC2, transitGatewayAttachmentID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayAttachmentStatePending},
+		Target:  []string{ec2.TransitGatewayAttachmentStateAvailable},
+		Refresh: transitGatewayConnectRefreshFunc(conn, transitGatewayAttachmentID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Transit Gateway Connect (%s) availability", transitGatewayAttachmentID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func WaitForTransitGatewayConnectDeletion(conn *ec2.EC2, transitGatewayAttachmentID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.TransitGatewayAttachmentStateAvailable,
+			ec2.TransitGatewayAttachmentStateDeleting,
+		},
+		Target:         []string{ec2.TransitGatewayAttachmentStateDeleted},
+		Refresh:        transitGatewayConnectRefreshFunc(conn, transitGatewayAttachmentID),
+		Timeout:        10 * time.Minute,
+		NotFoundChecks: 1,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Transit Gateway Connect (%s) deletion", transitGatewayAttachmentID)
+	_, err := stateConf.WaitForState()
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+func waitForTransitGatewayConnectPeerCreation(conn *ec2.EC2, transitGatewayConnectPeerID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayConnectPeerStatePending},
+		Target:  []string{ec2.TransitGatewayConnectPeerStateAvailable},
+		Refresh: transitGatewayConnectPeerRefreshFunc(conn, transitGatewayConnectPeerID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Transit Gateway Connect Peer (%s) availability", transitGatewayConnectPeerID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func WaitForTransitGatewayConnectPeerDeletion(conn *ec2.EC2, transitGatewayConnectPeerID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.TransitGatewayConnectPeerStateAvailable,
+			ec2.TransitGatewayConnectPeerStateDeleting,
+		},
+		Target:         []string{ec2.TransitGatewayConnectPeerStateDeleted},
+		Refresh:        transitGatewayConnectPeerRefreshFunc(conn, transitGatewayConnectPeerID),
+		Timeout:        10 * time.Minute,
+		NotFoundChecks: 1,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Transit Gateway Connect Peer (%s) deletion", transitGatewayConnectPeerID)
+	_, err := stateConf.WaitForState()
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+func waitForTransitGatewayMulticastDomainAssociationCreation(conn *ec2.EC2, transitGatewayMulticastDomainID, transitGatewayAttachmentID, subnetID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayMulitcastDomainAssociationStateAssociating},
+		Target:  []string{ec2.TransitGatewayMulitcastDomainAssociationStateAssociated},
+		Refresh: transitGatewayMulticastDomainAssociationRefreshFunc(conn, transitGatewayMulticastDomainID, transitGatewayAttachmentID, subnetID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Transit Gateway Multicast Domain (%s) association: %s/%s", transitGatewayMulticastDomainID, transitGatewayAttachmentID, subnetID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForTransitGatewayMulticastDomainAssociationDeletion(conn *ec2.EC2, transitGatewayMulticastDomainID, transitGatewayAttachmentID, subnetID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.TransitGatewayMulitcastDomainAssociationStateAssociated,
+			ec2.TransitGatewayMulitcastDomainAssociationStateDisassociating,
+		},
+		Target:         []string{ec2.TransitGatewayMulitcastDomainAssociationStateDisassociated},
+		Refresh:        transitGatewayMulticastDomainAssociationRefreshFunc(conn, transitGatewayMulticastDomainID, transitGatewayAttachmentID, subnetID),
+		Timeout:        10 * time.Minute,
+		NotFoundChecks: 1,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Transit Gateway Multicast Domain (%s) disassociation: %s/%s", transitGatewayMulticastDomainID, transitGatewayAttachmentID, subnetID)
+	_, err := stateConf.WaitForState()
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+func waitForTransitGatewayMulticastDomainCreation(conn *ec2.EC2, transitGatewayMulticastDomainID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayMulticastDomainStatePending},
+		Target:  []string{ec2.TransitGatewayMulticastDomainStateAvailable},
+		Refresh: transitGatewayMulticastDomainRefreshFunc(conn, transitGatewayMulticastDomainID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Transit Gateway Multicast Domain (%s) availability", transitGatewayMulticastDomainID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func WaitForTransitGatewayMulticastDomainDeletion(conn *ec2.EC2, transitGatewayMulticastDomainID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.TransitGatewayMulticastDomainStateAvailable,
+			ec2.TransitGatewayMulticastDomainStateDeleting,
+		},
+		Target:         []string{ec2.TransitGatewayMulticastDomainStateDeleted},
+		Refresh:        transitGatewayMulticastDomainRefreshFunc(conn, transitGatewayMulticastDomainID),
+		Timeout:        10 * time.Minute,
+		NotFoundChecks: 1,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Transit Gateway Multicast Domain (%s) deletion", transitGatewayMulticastDomainID)
+	_, err := stateConf.WaitForState()
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
 func waitForTransitGatewayPeeringAttachmentAcceptance(conn *ec2.EC2, transitGatewayAttachmentID string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
@@ -0,0 +1,223 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTransitGatewayConnectPeer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTransitGatewayConnectPeerCreate,
+		Read:   resourceTransitGatewayConnectPeerRead,
+		Update: resourceTransitGatewayConnectPeerUpdate,
+		Delete: resourceTransitGatewayConnectPeerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bgp_asn": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"inside_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidCIDRNetworkAddress,
+				},
+			},
+			"peer_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"transit_gateway_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"transit_gateway_attachment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceTransitGatewayConnectPeerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateTransitGatewayConnectPeerInput{
+		InsideCidrBlocks:           flex.ExpandStringSet(d.Get("inside_cidr_blocks").(*schema.Set)),
+		PeerAddress:                aws.String(d.Get("peer_address").(string)),
+		TagSpecifications:          ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeTransitGatewayConnectPeer),
+		TransitGatewayAttachmentId: aws.String(d.Get("transit_gateway_attachment_id").(string)),
+	}
+
+	if v, ok := d.GetOk("bgp_asn"); ok {
+		input.BgpOptions = &ec2.TransitGatewayConnectRequestBgpOptions{
+			PeerAsn: aws.Int64(int64(v.(int))),
+		}
+	}
+
+	if v, ok := d.GetOk("transit_gateway_address"); ok {
+		input.TransitGatewayAddress = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Transit Gateway Connect Peer: %s", input)
+	output, err := conn.CreateTransitGatewayConnectPeer(input)
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Transit Gateway Connect Peer: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.TransitGatewayConnectPeer.TransitGatewayConnectPeerId))
+
+	if err := waitForTransitGatewayConnectPeerCreation(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Connect Peer (%s) availability: %s", d.Id(), err)
+	}
+
+	return resourceTransitGatewayConnectPeerRead(d, meta)
+}
+
+func resourceTransitGatewayConnectPeerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	transitGatewayConnectPeer, err := DescribeTransitGatewayConnectPeer(conn, d.Id())
+
+	if tfawserr.ErrMessageContains(err, ErrCodeInvalidTransitGatewayConnectPeerIDNotFound, "") {
+		log.Printf("[WARN] EC2 Transit Gateway Connect Peer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Connect Peer: %s", err)
+	}
+
+	if transitGatewayConnectPeer == nil {
+		log.Printf("[WARN] EC2 Transit Gateway Connect Peer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if aws.StringValue(transitGatewayConnectPeer.State) == ec2.TransitGatewayConnectPeerStateDeleting || aws.StringValue(transitGatewayConnectPeer.State) == ec2.TransitGatewayConnectPeerStateDeleted {
+		log.Printf("[WARN] EC2 Transit Gateway Connect Peer (%s) in deleted state (%s), removing from state", d.Id(), aws.StringValue(transitGatewayConnectPeer.State))
+		d.SetId("")
+		return nil
+	}
+
+	configuration := transitGatewayConnectPeer.ConnectPeerConfiguration
+
+	if configuration == nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Connect Peer (%s): missing configuration", d.Id())
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("transit-gateway-connect-peer/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+
+	// All BGP configurations share the same peer ASN.
+	if len(configuration.BgpConfigurations) > 0 && configuration.BgpConfigurations[0] != nil {
+		d.Set("bgp_asn", configuration.BgpConfigurations[0].PeerAsn)
+	}
+
+	if err := d.Set("inside_cidr_blocks", aws.StringValueSlice(configuration.InsideCidrBlocks)); err != nil {
+		return fmt.Errorf("error setting inside_cidr_blocks: %s", err)
+	}
+
+	d.Set("peer_address", configuration.PeerAddress)
+	d.Set("transit_gateway_address", configuration.TransitGatewayAddress)
+	d.Set("transit_gateway_attachment_id", transitGatewayConnectPeer.TransitGatewayAttachmentId)
+
+	tags := KeyValueTags(transitGatewayConnectPeer.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceTransitGatewayConnectPeerUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 Transit Gateway Connect Peer (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceTransitGatewayConnectPeerRead(d, meta)
+}
+
+func resourceTransitGatewayConnectPeerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	input := &ec2.DeleteTransitGatewayConnectPeerInput{
+		TransitGatewayConnectPeerId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Connect Peer (%s): %s", d.Id(), input)
+	_, err := conn.DeleteTransitGatewayConnectPeer(input)
+
+	if tfawserr.ErrMessageContains(err, ErrCodeInvalidTransitGatewayConnectPeerIDNotFound, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Transit Gateway Connect Peer: %s", err)
+	}
+
+	if err := WaitForTransitGatewayConnectPeerDeletion(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Connect Peer (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,221 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func testAccTransitGatewayConnectPeer_basic(t *testing.T) {
+	var transitGatewayConnectPeer1 ec2.TransitGatewayConnectPeer
+	resourceName := "aws_ec2_transit_gateway_connect_peer.test"
+	transitGatewayConnectResourceName := "aws_ec2_transit_gateway_connect.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayConnectPeerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayConnectPeerConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayConnectPeerExists(resourceName, &transitGatewayConnectPeer1),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`transit-gateway-connect-peer/tgw-connect-peer-.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "bgp_asn"),
+					resource.TestCheckResourceAttr(resourceName, "inside_cidr_blocks.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "inside_cidr_blocks.*", "169.254.200.0/29"),
+					resource.TestCheckResourceAttr(resourceName, "peer_address", "1.1.1.1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "transit_gateway_address"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_attachment_id", transitGatewayConnectResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayConnectPeer_disappears(t *testing.T) {
+	var transitGatewayConnectPeer1 ec2.TransitGatewayConnectPeer
+	resourceName := "aws_ec2_transit_gateway_connect_peer.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayConnectPeerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayConnectPeerConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayConnectPeerExists(resourceName, &transitGatewayConnectPeer1),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceTransitGatewayConnectPeer(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayConnectPeer_BgpAsn(t *testing.T) {
+	var transitGatewayConnectPeer1 ec2.TransitGatewayConnectPeer
+	resourceName := "aws_ec2_transit_gateway_connect_peer.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayConnectPeerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayConnectPeerBgpAsnConfig(4200000000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayConnectPeerExists(resourceName, &transitGatewayConnectPeer1),
+					resource.TestCheckResourceAttr(resourceName, "bgp_asn", "4200000000"),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_address", "10.20.128.1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTransitGatewayConnectPeerExists(resourceName string, transitGatewayConnectPeer *ec2.TransitGatewayConnectPeer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Transit Gateway Connect Peer ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		connectPeer, err := tfec2.DescribeTransitGatewayConnectPeer(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if connectPeer == nil {
+			return fmt.Errorf("EC2 Transit Gateway Connect Peer not found")
+		}
+
+		if aws.StringValue(connectPeer.State) != ec2.TransitGatewayConnectPeerStateAvailable {
+			return fmt.Errorf("EC2 Transit Gateway Connect Peer (%s) exists in non-available (%s) state", rs.Primary.ID, aws.StringValue(connectPeer.State))
+		}
+
+		*transitGatewayConnectPeer = *connectPeer
+
+		return nil
+	}
+}
+
+func testAccCheckTransitGatewayConnectPeerDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_transit_gateway_connect_peer" {
+			continue
+		}
+
+		connectPeer, err := tfec2.DescribeTransitGatewayConnectPeer(conn, rs.Primary.ID)
+
+		if tfawserr.ErrMessageContains(err, tfec2.ErrCodeInvalidTransitGatewayConnectPeerIDNotFound, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if connectPeer == nil {
+			continue
+		}
+
+		if aws.StringValue(connectPeer.State) != ec2.TransitGatewayConnectPeerStateDeleted {
+			return fmt.Errorf("EC2 Transit Gateway Connect Peer (%s) still exists in non-deleted (%s) state", rs.Primary.ID, aws.StringValue(connectPeer.State))
+		}
+	}
+
+	return nil
+}
+
+func testAccTransitGatewayConnectPeerBaseConfig() string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInDefaultExclude(), `
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = "tf-acc-test-ec2-transit-gateway-connect-peer"
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = "tf-acc-test-ec2-transit-gateway-connect-peer"
+  }
+}
+
+resource "aws_ec2_transit_gateway" "test" {
+  transit_gateway_cidr_blocks = ["10.20.128.0/20"]
+}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  subnet_ids         = [aws_subnet.test.id]
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  vpc_id             = aws_vpc.test.id
+}
+
+resource "aws_ec2_transit_gateway_connect" "test" {
+  transit_gateway_id      = aws_ec2_transit_gateway.test.id
+  transport_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+}
+`)
+}
+
+func testAccTransitGatewayConnectPeerConfig() string {
+	return acctest.ConfigCompose(testAccTransitGatewayConnectPeerBaseConfig(), `
+resource "aws_ec2_transit_gateway_connect_peer" "test" {
+  inside_cidr_blocks            = ["169.254.200.0/29"]
+  peer_address                  = "1.1.1.1"
+  transit_gateway_attachment_id = aws_ec2_transit_gateway_connect.test.id
+}
+`)
+}
+
+func testAccTransitGatewayConnectPeerBgpAsnConfig(bgpAsn int) string {
+	return acctest.ConfigCompose(testAccTransitGatewayConnectPeerBaseConfig(), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_connect_peer" "test" {
+  bgp_asn                       = %[1]d
+  inside_cidr_blocks            = ["169.254.200.0/29"]
+  peer_address                  = "1.1.1.1"
+  transit_gateway_address       = "10.20.128.1"
+  transit_gateway_attachment_id = aws_ec2_transit_gateway_connect.test.id
+}
+`, bgpAsn))
+}
@@ -0,0 +1,295 @@
+package ec2_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func testAccTransitGatewayConnect_basic(t *testing.T) {
+	var transitGatewayConnect1 ec2.TransitGatewayConnect
+	resourceName := "aws_ec2_transit_gateway_connect.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+	transitGatewayVpcAttachmentResourceName := "aws_ec2_transit_gateway_vpc_attachment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayConnectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayConnectConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayConnectExists(resourceName, &transitGatewayConnect1),
+					resource.TestCheckResourceAttr(resourceName, "protocol", ec2.ProtocolValueGre),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_default_route_table_association", "true"),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_default_route_table_propagation", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", transitGatewayResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transport_attachment_id", transitGatewayVpcAttachmentResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayConnect_disappears(t *testing.T) {
+	var transitGatewayConnect1 ec2.TransitGatewayConnect
+	resourceName := "aws_ec2_transit_gateway_connect.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayConnectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayConnectConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayConnectExists(resourceName, &transitGatewayConnect1),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceTransitGatewayConnect(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayConnect_Tags(t *testing.T) {
+	var transitGatewayConnect1, transitGatewayConnect2, transitGatewayConnect3 ec2.TransitGatewayConnect
+	resourceName := "aws_ec2_transit_gateway_connect.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayConnectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayConnectTags1Config("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayConnectExists(resourceName, &transitGatewayConnect1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTransitGatewayConnectTags2Config("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayConnectExists(resourceName, &transitGatewayConnect2),
+					testAccCheckTransitGatewayConnectNotRecreated(&transitGatewayConnect1, &transitGatewayConnect2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccTransitGatewayConnectTags1Config("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayConnectExists(resourceName, &transitGatewayConnect3),
+					testAccCheckTransitGatewayConnectNotRecreated(&transitGatewayConnect2, &transitGatewayConnect3),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayConnect_TransitGatewayDefaultRouteTableAssociationAndPropagationDisabled(t *testing.T) {
+	var transitGatewayConnect1 ec2.TransitGatewayConnect
+	resourceName := "aws_ec2_transit_gateway_connect.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayConnectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayConnectTransitGatewayDefaultRouteTableAssociationAndPropagationConfig(false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayConnectExists(resourceName, &transitGatewayConnect1),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_default_route_table_association", "false"),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway_default_route_table_propagation", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTransitGatewayConnectExists(resourceName string, transitGatewayConnect *ec2.TransitGatewayConnect) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Transit Gateway Connect ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		connect, err := tfec2.DescribeTransitGatewayConnect(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if connect == nil {
+			return fmt.Errorf("EC2 Transit Gateway Connect not found")
+		}
+
+		if aws.StringValue(connect.State) != ec2.TransitGatewayAttachmentStateAvailable {
+			return fmt.Errorf("EC2 Transit Gateway Connect (%s) exists in non-available (%s) state", rs.Primary.ID, aws.StringValue(connect.State))
+		}
+
+		*transitGatewayConnect = *connect
+
+		return nil
+	}
+}
+
+func testAccCheckTransitGatewayConnectDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_transit_gateway_connect" {
+			continue
+		}
+
+		connect, err := tfec2.DescribeTransitGatewayConnect(conn, rs.Primary.ID)
+
+		if tfawserr.ErrMessageContains(err, "InvalidTransitGatewayAttachmentID.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if connect == nil {
+			continue
+		}
+
+		if aws.StringValue(connect.State) != ec2.TransitGatewayAttachmentStateDeleted {
+			return fmt.Errorf("EC2 Transit Gateway Connect (%s) still exists in non-deleted (%s) state", rs.Primary.ID, aws.StringValue(connect.State))
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckTransitGatewayConnectNotRecreated(i, j *ec2.TransitGatewayConnect) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.TransitGatewayAttachmentId) != aws.StringValue(j.TransitGatewayAttachmentId) {
+			return errors.New("EC2 Transit Gateway Connect was recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccTransitGatewayConnectBaseConfig() string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInDefaultExclude(), `
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = "tf-acc-test-ec2-transit-gateway-connect"
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = "tf-acc-test-ec2-transit-gateway-connect"
+  }
+}
+
+resource "aws_ec2_transit_gateway" "test" {}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  subnet_ids         = [aws_subnet.test.id]
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  vpc_id             = aws_vpc.test.id
+}
+`)
+}
+
+func testAccTransitGatewayConnectConfig() string {
+	return acctest.ConfigCompose(testAccTransitGatewayConnectBaseConfig(), `
+resource "aws_ec2_transit_gateway_connect" "test" {
+  transit_gateway_id      = aws_ec2_transit_gateway.test.id
+  transport_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+}
+`)
+}
+
+func testAccTransitGatewayConnectTags1Config(tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayConnectBaseConfig(), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_connect" "test" {
+  transit_gateway_id      = aws_ec2_transit_gateway.test.id
+  transport_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccTransitGatewayConnectTags2Config(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayConnectBaseConfig(), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_connect" "test" {
+  transit_gateway_id      = aws_ec2_transit_gateway.test.id
+  transport_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccTransitGatewayConnectTransitGatewayDefaultRouteTableAssociationAndPropagationConfig(association, propagation bool) string {
+	return acctest.ConfigCompose(testAccTransitGatewayConnectBaseConfig(), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_connect" "test" {
+  transit_gateway_id      = aws_ec2_transit_gateway.test.id
+  transport_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+
+  transit_gateway_default_route_table_association = %[1]t
+  transit_gateway_default_route_table_propagation = %[2]t
+}
+`, association, propagation))
+}
@@ -0,0 +1,192 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceTransitGatewayMulticastDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTransitGatewayMulticastDomainCreate,
+		Read:   resourceTransitGatewayMulticastDomainRead,
+		Update: resourceTransitGatewayMulticastDomainUpdate,
+		Delete: resourceTransitGatewayMulticastDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_accept_shared_associations": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.AutoAcceptSharedAssociationsValueDisable,
+				ValidateFunc: validation.StringInSlice(ec2.AutoAcceptSharedAssociationsValue_Values(), false),
+			},
+			"igmpv2_support": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.Igmpv2SupportValueDisable,
+				ValidateFunc: validation.StringInSlice(ec2.Igmpv2SupportValue_Values(), false),
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"static_sources_support": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ec2.StaticSourcesSupportValueDisable,
+				ValidateFunc: validation.StringInSlice(ec2.StaticSourcesSupportValue_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"transit_gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceTransitGatewayMulticastDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ec2.CreateTransitGatewayMulticastDomainInput{
+		Options: &ec2.CreateTransitGatewayMulticastDomainRequestOptions{
+			AutoAcceptSharedAssociations: aws.String(d.Get("auto_accept_shared_associations").(string)),
+			Igmpv2Support:                aws.String(d.Get("igmpv2_support").(string)),
+			StaticSourcesSupport:         aws.String(d.Get("static_sources_support").(string)),
+		},
+		TagSpecifications: ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeTransitGatewayMulticastDomain),
+		TransitGatewayId:  aws.String(d.Get("transit_gateway_id").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Transit Gateway Multicast Domain: %s", input)
+	output, err := conn.CreateTransitGatewayMulticastDomain(input)
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Transit Gateway Multicast Domain: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.TransitGatewayMulticastDomain.TransitGatewayMulticastDomainId))
+
+	if err := waitForTransitGatewayMulticastDomainCreation(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Multicast Domain (%s) availability: %s", d.Id(), err)
+	}
+
+	return resourceTransitGatewayMulticastDomainRead(d, meta)
+}
+
+func resourceTransitGatewayMulticastDomainRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	multicastDomain, err := DescribeTransitGatewayMulticastDomain(conn, d.Id())
+
+	if tfawserr.ErrMessageContains(err, ErrCodeInvalidTransitGatewayMulticastDomainIDNotFound, "") {
+		log.Printf("[WARN] EC2 Transit Gateway Multicast Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Multicast Domain: %s", err)
+	}
+
+	if multicastDomain == nil {
+		log.Printf("[WARN] EC2 Transit Gateway Multicast Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if aws.StringValue(multicastDomain.State) == ec2.TransitGatewayMulticastDomainStateDeleting || aws.StringValue(multicastDomain.State) == ec2.TransitGatewayMulticastDomainStateDeleted {
+		log.Printf("[WARN] EC2 Transit Gateway Multicast Domain (%s) in deleted state (%s), removing from state", d.Id(), aws.StringValue(multicastDomain.State))
+		d.SetId("")
+		return nil
+	}
+
+	if multicastDomain.Options == nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Multicast Domain (%s): missing options", d.Id())
+	}
+
+	d.Set("arn", multicastDomain.TransitGatewayMulticastDomainArn)
+	d.Set("auto_accept_shared_associations", multicastDomain.Options.AutoAcceptSharedAssociations)
+	d.Set("igmpv2_support", multicastDomain.Options.Igmpv2Support)
+	d.Set("owner_id", multicastDomain.OwnerId)
+	d.Set("static_sources_support", multicastDomain.Options.StaticSourcesSupport)
+	d.Set("transit_gateway_id", multicastDomain.TransitGatewayId)
+
+	tags := KeyValueTags(multicastDomain.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceTransitGatewayMulticastDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating EC2 Transit Gateway Multicast Domain (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceTransitGatewayMulticastDomainRead(d, meta)
+}
+
+func resourceTransitGatewayMulticastDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	input := &ec2.DeleteTransitGatewayMulticastDomainInput{
+		TransitGatewayMulticastDomainId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Multicast Domain (%s): %s", d.Id(), input)
+	_, err := conn.DeleteTransitGatewayMulticastDomain(input)
+
+	if tfawserr.ErrMessageContains(err, ErrCodeInvalidTransitGatewayMulticastDomainIDNotFound, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Transit Gateway Multicast Domain: %s", err)
+	}
+
+	if err := WaitForTransitGatewayMulticastDomainDeletion(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Multicast Domain (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceTransitGatewayMulticastDomainAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTransitGatewayMulticastDomainAssociationCreate,
+		Read:   resourceTransitGatewayMulticastDomainAssociationRead,
+		Delete: resourceTransitGatewayMulticastDomainAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"transit_gateway_attachment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"transit_gateway_multicast_domain_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceTransitGatewayMulticastDomainAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	multicastDomainID := d.Get("transit_gateway_multicast_domain_id").(string)
+	attachmentID := d.Get("transit_gateway_attachment_id").(string)
+	subnetID := d.Get("subnet_id").(string)
+	id := fmt.Sprintf("%s_%s_%s", multicastDomainID, attachmentID, subnetID)
+
+	input := &ec2.AssociateTransitGatewayMulticastDomainInput{
+		SubnetIds:                       aws.StringSlice([]string{subnetID}),
+		TransitGatewayAttachmentId:      aws.String(attachmentID),
+		TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+	}
+
+	log.Printf("[DEBUG] Associating EC2 Transit Gateway Multicast Domain: %s", input)
+	if _, err := conn.AssociateTransitGatewayMulticastDomain(input); err != nil {
+		return fmt.Errorf("error associating EC2 Transit Gateway Multicast Domain (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if err := waitForTransitGatewayMulticastDomainAssociationCreation(conn, multicastDomainID, attachmentID, subnetID); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Multicast Domain association (%s): %s", d.Id(), err)
+	}
+
+	return resourceTransitGatewayMulticastDomainAssociationRead(d, meta)
+}
+
+func resourceTransitGatewayMulticastDomainAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	multicastDomainID, attachmentID, subnetID, err := DecodeTransitGatewayMulticastDomainAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	association, err := DescribeTransitGatewayMulticastDomainAssociation(conn, multicastDomainID, attachmentID, subnetID)
+
+	if tfawserr.ErrMessageContains(err, ErrCodeInvalidTransitGatewayMulticastDomainIDNotFound, "") {
+		log.Printf("[WARN] EC2 Transit Gateway Multicast Domain (%s) not found, removing from state", multicastDomainID)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Multicast Domain association (%s): %s", d.Id(), err)
+	}
+
+	if association == nil {
+		log.Printf("[WARN] EC2 Transit Gateway Multicast Domain association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if state := aws.StringValue(association.Subnet.State); state == ec2.TransitGatewayMulitcastDomainAssociationStateDisassociating || state == ec2.TransitGatewayMulitcastDomainAssociationStateDisassociated {
+		log.Printf("[WARN] EC2 Transit Gateway Multicast Domain association (%s) in deleted state (%s), removing from state", d.Id(), state)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("subnet_id", association.Subnet.SubnetId)
+	d.Set("transit_gateway_attachment_id", association.TransitGatewayAttachmentId)
+	d.Set("transit_gateway_multicast_domain_id", multicastDomainID)
+
+	return nil
+}
+
+func resourceTransitGatewayMulticastDomainAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	multicastDomainID, attachmentID, subnetID, err := DecodeTransitGatewayMulticastDomainAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &ec2.DisassociateTransitGatewayMulticastDomainInput{
+		SubnetIds:                       aws.StringSlice([]string{subnetID}),
+		TransitGatewayAttachmentId:      aws.String(attachmentID),
+		TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+	}
+
+	log.Printf("[DEBUG] Disassociating EC2 Transit Gateway Multicast Domain (%s): %s", d.Id(), input)
+	_, err = conn.DisassociateTransitGatewayMulticastDomain(input)
+
+	if tfawserr.ErrMessageContains(err, ErrCodeInvalidTransitGatewayMulticastDomainIDNotFound, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating EC2 Transit Gateway Multicast Domain (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForTransitGatewayMulticastDomainAssociationDeletion(conn, multicastDomainID, attachmentID, subnetID); err != nil {
+		return fmt.Errorf("error waiting for EC2 Transit Gateway Multicast Domain disassociation (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func testAccTransitGatewayMulticastDomainAssociation_basic(t *testing.T) {
+	resourceName := "aws_ec2_transit_gateway_multicast_domain_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayMulticastDomainAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayMulticastDomainAssociationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayMulticastDomainAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_id", "aws_subnet.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_attachment_id", "aws_ec2_transit_gateway_vpc_attachment.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_multicast_domain_id", "aws_ec2_transit_gateway_multicast_domain.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayMulticastDomainAssociation_disappears(t *testing.T) {
+	resourceName := "aws_ec2_transit_gateway_multicast_domain_association.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayMulticastDomainAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayMulticastDomainAssociationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayMulticastDomainAssociationExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceTransitGatewayMulticastDomainAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTransitGatewayMulticastDomainAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Transit Gateway Multicast Domain Association ID is set")
+		}
+
+		multicastDomainID, attachmentID, subnetID, err := tfec2.DecodeTransitGatewayMulticastDomainAssociationID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		association, err := tfec2.DescribeTransitGatewayMulticastDomainAssociation(conn, multicastDomainID, attachmentID, subnetID)
+
+		if err != nil {
+			return err
+		}
+
+		if association == nil {
+			return fmt.Errorf("EC2 Transit Gateway Multicast Domain Association not found")
+		}
+
+		if state := aws.StringValue(association.Subnet.State); state != ec2.TransitGatewayMulitcastDomainAssociationStateAssociated {
+			return fmt.Errorf("EC2 Transit Gateway Multicast Domain Association (%s) exists in non-associated (%s) state", rs.Primary.ID, state)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTransitGatewayMulticastDomainAssociationDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_transit_gateway_multicast_domain_association" {
+			continue
+		}
+
+		multicastDomainID, attachmentID, subnetID, err := tfec2.DecodeTransitGatewayMulticastDomainAssociationID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		association, err := tfec2.DescribeTransitGatewayMulticastDomainAssociation(conn, multicastDomainID, attachmentID, subnetID)
+
+		if tfawserr.ErrMessageContains(err, tfec2.ErrCodeInvalidTransitGatewayMulticastDomainIDNotFound, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if association == nil {
+			continue
+		}
+
+		if state := aws.StringValue(association.Subnet.State); state != ec2.TransitGatewayMulitcastDomainAssociationStateDisassociated {
+			return fmt.Errorf("EC2 Transit Gateway Multicast Domain Association (%s) still exists in non-disassociated (%s) state", rs.Primary.ID, state)
+		}
+	}
+
+	return nil
+}
+
+func testAccTransitGatewayMulticastDomainAssociationBaseConfig() string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInDefaultExclude(), `
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = "tf-acc-test-ec2-transit-gateway-multicast"
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = "tf-acc-test-ec2-transit-gateway-multicast"
+  }
+}
+
+resource "aws_ec2_transit_gateway" "test" {
+  multicast_support = "enable"
+}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  subnet_ids         = [aws_subnet.test.id]
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  vpc_id             = aws_vpc.test.id
+}
+
+resource "aws_ec2_transit_gateway_multicast_domain" "test" {
+  transit_gateway_id     = aws_ec2_transit_gateway.test.id
+  static_sources_support = "enable"
+}
+`)
+}
+
+func testAccTransitGatewayMulticastDomainAssociationConfig() string {
+	return acctest.ConfigCompose(testAccTransitGatewayMulticastDomainAssociationBaseConfig(), `
+resource "aws_ec2_transit_gateway_multicast_domain_association" "test" {
+  subnet_id                           = aws_subnet.test.id
+  transit_gateway_attachment_id       = aws_ec2_transit_gateway_vpc_attachment.test.id
+  transit_gateway_multicast_domain_id = aws_ec2_transit_gateway_multicast_domain.test.id
+}
+`)
+}
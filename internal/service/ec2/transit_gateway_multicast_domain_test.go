@@ -0,0 +1,185 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func testAccTransitGatewayMulticastDomain_basic(t *testing.T) {
+	var multicastDomain1 ec2.TransitGatewayMulticastDomain
+	resourceName := "aws_ec2_transit_gateway_multicast_domain.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayMulticastDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayMulticastDomainConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayMulticastDomainExists(resourceName, &multicastDomain1),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`transit-gateway-multicast-domain/tgw-mcast-domain-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "auto_accept_shared_associations", ec2.AutoAcceptSharedAssociationsValueDisable),
+					resource.TestCheckResourceAttr(resourceName, "igmpv2_support", ec2.Igmpv2SupportValueDisable),
+					acctest.CheckResourceAttrAccountID(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "static_sources_support", ec2.StaticSourcesSupportValueDisable),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", transitGatewayResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayMulticastDomain_disappears(t *testing.T) {
+	var multicastDomain1 ec2.TransitGatewayMulticastDomain
+	resourceName := "aws_ec2_transit_gateway_multicast_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayMulticastDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayMulticastDomainConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayMulticastDomainExists(resourceName, &multicastDomain1),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceTransitGatewayMulticastDomain(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayMulticastDomain_IGMPv2Support(t *testing.T) {
+	var multicastDomain1 ec2.TransitGatewayMulticastDomain
+	resourceName := "aws_ec2_transit_gateway_multicast_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayMulticastDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayMulticastDomainIGMPv2SupportConfig(ec2.Igmpv2SupportValueEnable),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayMulticastDomainExists(resourceName, &multicastDomain1),
+					resource.TestCheckResourceAttr(resourceName, "igmpv2_support", ec2.Igmpv2SupportValueEnable),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTransitGatewayMulticastDomainExists(resourceName string, multicastDomain *ec2.TransitGatewayMulticastDomain) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Transit Gateway Multicast Domain ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.DescribeTransitGatewayMulticastDomain(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("EC2 Transit Gateway Multicast Domain not found")
+		}
+
+		if aws.StringValue(output.State) != ec2.TransitGatewayMulticastDomainStateAvailable {
+			return fmt.Errorf("EC2 Transit Gateway Multicast Domain (%s) exists in non-available (%s) state", rs.Primary.ID, aws.StringValue(output.State))
+		}
+
+		*multicastDomain = *output
+
+		return nil
+	}
+}
+
+func testAccCheckTransitGatewayMulticastDomainDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_transit_gateway_multicast_domain" {
+			continue
+		}
+
+		output, err := tfec2.DescribeTransitGatewayMulticastDomain(conn, rs.Primary.ID)
+
+		if tfawserr.ErrMessageContains(err, tfec2.ErrCodeInvalidTransitGatewayMulticastDomainIDNotFound, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			continue
+		}
+
+		if aws.StringValue(output.State) != ec2.TransitGatewayMulticastDomainStateDeleted {
+			return fmt.Errorf("EC2 Transit Gateway Multicast Domain (%s) still exists in non-deleted (%s) state", rs.Primary.ID, aws.StringValue(output.State))
+		}
+	}
+
+	return nil
+}
+
+func testAccTransitGatewayMulticastDomainConfig() string {
+	return `
+resource "aws_ec2_transit_gateway" "test" {
+  multicast_support = "enable"
+}
+
+resource "aws_ec2_transit_gateway_multicast_domain" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+}
+`
+}
+
+func testAccTransitGatewayMulticastDomainIGMPv2SupportConfig(igmpv2Support string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  multicast_support = "enable"
+}
+
+resource "aws_ec2_transit_gateway_multicast_domain" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  igmpv2_support     = %[1]q
+}
+`, igmpv2Support)
+}
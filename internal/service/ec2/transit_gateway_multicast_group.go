@@ -0,0 +1,199 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// transitGatewayMulticastGroupKind describes a kind of registration of a network interface with a multicast group,
+// either as a group member or as a group source.
+type transitGatewayMulticastGroupKind struct {
+	// description is used in log and error messages, e.g. "EC2 Transit Gateway Multicast Group Member".
+	description string
+	// filterName is the SearchTransitGatewayMulticastGroups filter that selects registrations of this kind.
+	filterName string
+	register   func(conn *ec2.EC2, multicastDomainID, groupIPAddress, networkInterfaceID string) error
+	deregister func(conn *ec2.EC2, multicastDomainID, groupIPAddress, networkInterfaceID string) error
+}
+
+var transitGatewayMulticastGroupMember = transitGatewayMulticastGroupKind{
+	description: "EC2 Transit Gateway Multicast Group Member",
+	filterName:  "is-group-member",
+	register: func(conn *ec2.EC2, multicastDomainID, groupIPAddress, networkInterfaceID string) error {
+		input := &ec2.RegisterTransitGatewayMulticastGroupMembersInput{
+			GroupIpAddress:                  aws.String(groupIPAddress),
+			NetworkInterfaceIds:             aws.StringSlice([]string{networkInterfaceID}),
+			TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+		}
+
+		log.Printf("[DEBUG] Registering EC2 Transit Gateway Multicast Group Member: %s", input)
+		_, err := conn.RegisterTransitGatewayMulticastGroupMembers(input)
+
+		return err
+	},
+	deregister: func(conn *ec2.EC2, multicastDomainID, groupIPAddress, networkInterfaceID string) error {
+		input := &ec2.DeregisterTransitGatewayMulticastGroupMembersInput{
+			GroupIpAddress:                  aws.String(groupIPAddress),
+			NetworkInterfaceIds:             aws.StringSlice([]string{networkInterfaceID}),
+			TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+		}
+
+		log.Printf("[DEBUG] Deregistering EC2 Transit Gateway Multicast Group Member: %s", input)
+		_, err := conn.DeregisterTransitGatewayMulticastGroupMembers(input)
+
+		return err
+	},
+}
+
+var transitGatewayMulticastGroupSource = transitGatewayMulticastGroupKind{
+	description: "EC2 Transit Gateway Multicast Group Source",
+	filterName:  "is-group-source",
+	register: func(conn *ec2.EC2, multicastDomainID, groupIPAddress, networkInterfaceID string) error {
+		input := &ec2.RegisterTransitGatewayMulticastGroupSourcesInput{
+			GroupIpAddress:                  aws.String(groupIPAddress),
+			NetworkInterfaceIds:             aws.StringSlice([]string{networkInterfaceID}),
+			TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+		}
+
+		log.Printf("[DEBUG] Registering EC2 Transit Gateway Multicast Group Source: %s", input)
+		_, err := conn.RegisterTransitGatewayMulticastGroupSources(input)
+
+		return err
+	},
+	deregister: func(conn *ec2.EC2, multicastDomainID, groupIPAddress, networkInterfaceID string) error {
+		input := &ec2.DeregisterTransitGatewayMulticastGroupSourcesInput{
+			GroupIpAddress:                  aws.String(groupIPAddress),
+			NetworkInterfaceIds:             aws.StringSlice([]string{networkInterfaceID}),
+			TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+		}
+
+		log.Printf("[DEBUG] Deregistering EC2 Transit Gateway Multicast Group Source: %s", input)
+		_, err := conn.DeregisterTransitGatewayMulticastGroupSources(input)
+
+		return err
+	},
+}
+
+func ResourceTransitGatewayMulticastGroupMember() *schema.Resource {
+	return resourceTransitGatewayMulticastGroup(transitGatewayMulticastGroupMember)
+}
+
+func ResourceTransitGatewayMulticastGroupSource() *schema.Resource {
+	return resourceTransitGatewayMulticastGroup(transitGatewayMulticastGroupSource)
+}
+
+func resourceTransitGatewayMulticastGroup(kind transitGatewayMulticastGroupKind) *schema.Resource {
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceTransitGatewayMulticastGroupCreate(d, meta, kind)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceTransitGatewayMulticastGroupRead(d, meta, kind)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return resourceTransitGatewayMulticastGroupDelete(d, meta, kind)
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"network_interface_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"transit_gateway_multicast_domain_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceTransitGatewayMulticastGroupCreate(d *schema.ResourceData, meta interface{}, kind transitGatewayMulticastGroupKind) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	multicastDomainID := d.Get("transit_gateway_multicast_domain_id").(string)
+	groupIPAddress := d.Get("group_ip_address").(string)
+	networkInterfaceID := d.Get("network_interface_id").(string)
+	id := fmt.Sprintf("%s_%s_%s", multicastDomainID, groupIPAddress, networkInterfaceID)
+
+	if err := kind.register(conn, multicastDomainID, groupIPAddress, networkInterfaceID); err != nil {
+		return fmt.Errorf("error registering %s (%s): %s", kind.description, id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceTransitGatewayMulticastGroupRead(d, meta, kind)
+}
+
+func resourceTransitGatewayMulticastGroupRead(d *schema.ResourceData, meta interface{}, kind transitGatewayMulticastGroupKind) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	multicastDomainID, groupIPAddress, networkInterfaceID, err := DecodeTransitGatewayMulticastGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Registrations are not immediately visible to SearchTransitGatewayMulticastGroups.
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(PropagationTimeout, func() (interface{}, error) {
+		return FindTransitGatewayMulticastGroup(conn, multicastDomainID, kind.filterName, groupIPAddress, networkInterfaceID)
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] %s (%s) not found, removing from state", kind.description, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading %s (%s): %w", kind.description, d.Id(), err)
+	}
+
+	multicastGroup := outputRaw.(*ec2.TransitGatewayMulticastGroup)
+
+	d.Set("group_ip_address", multicastGroup.GroupIpAddress)
+	d.Set("network_interface_id", multicastGroup.NetworkInterfaceId)
+	d.Set("transit_gateway_multicast_domain_id", multicastDomainID)
+
+	return nil
+}
+
+func resourceTransitGatewayMulticastGroupDelete(d *schema.ResourceData, meta interface{}, kind transitGatewayMulticastGroupKind) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	multicastDomainID, groupIPAddress, networkInterfaceID, err := DecodeTransitGatewayMulticastGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	err = kind.deregister(conn, multicastDomainID, groupIPAddress, networkInterfaceID)
+
+	if tfawserr.ErrMessageContains(err, ErrCodeInvalidTransitGatewayMulticastDomainIDNotFound, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deregistering %s (%s): %s", kind.description, d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func testAccTransitGatewayMulticastGroupMember_basic(t *testing.T) {
+	testAccTransitGatewayMulticastGroup_basic(t, "aws_ec2_transit_gateway_multicast_group_member", "is-group-member")
+}
+
+func testAccTransitGatewayMulticastGroupMember_disappears(t *testing.T) {
+	testAccTransitGatewayMulticastGroup_disappears(t, "aws_ec2_transit_gateway_multicast_group_member", "is-group-member", tfec2.ResourceTransitGatewayMulticastGroupMember())
+}
+
+func testAccTransitGatewayMulticastGroupSource_basic(t *testing.T) {
+	testAccTransitGatewayMulticastGroup_basic(t, "aws_ec2_transit_gateway_multicast_group_source", "is-group-source")
+}
+
+func testAccTransitGatewayMulticastGroupSource_disappears(t *testing.T) {
+	testAccTransitGatewayMulticastGroup_disappears(t, "aws_ec2_transit_gateway_multicast_group_source", "is-group-source", tfec2.ResourceTransitGatewayMulticastGroupSource())
+}
+
+func testAccTransitGatewayMulticastGroup_basic(t *testing.T, resourceType, filterName string) {
+	resourceName := resourceType + ".test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayMulticastGroupDestroy(resourceType, filterName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayMulticastGroupConfig(resourceType),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayMulticastGroupExists(resourceName, filterName),
+					resource.TestCheckResourceAttr(resourceName, "group_ip_address", "224.0.0.1"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_id", "aws_network_interface.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_multicast_domain_id", "aws_ec2_transit_gateway_multicast_domain.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayMulticastGroup_disappears(t *testing.T, resourceType, filterName string, r *schema.Resource) {
+	resourceName := resourceType + ".test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayMulticastGroupDestroy(resourceType, filterName),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayMulticastGroupConfig(resourceType),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayMulticastGroupExists(resourceName, filterName),
+					acctest.CheckResourceDisappears(acctest.Provider, r, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTransitGatewayMulticastGroupExists(resourceName, filterName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Transit Gateway Multicast Group ID is set")
+		}
+
+		multicastDomainID, groupIPAddress, networkInterfaceID, err := tfec2.DecodeTransitGatewayMulticastGroupID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		multicastGroup, err := tfec2.DescribeTransitGatewayMulticastGroup(conn, multicastDomainID, filterName, groupIPAddress, networkInterfaceID)
+
+		if err != nil {
+			return err
+		}
+
+		if multicastGroup == nil {
+			return fmt.Errorf("EC2 Transit Gateway Multicast Group (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTransitGatewayMulticastGroupDestroy(resourceType, filterName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			multicastDomainID, groupIPAddress, networkInterfaceID, err := tfec2.DecodeTransitGatewayMulticastGroupID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			multicastGroup, err := tfec2.DescribeTransitGatewayMulticastGroup(conn, multicastDomainID, filterName, groupIPAddress, networkInterfaceID)
+
+			if tfawserr.ErrMessageContains(err, tfec2.ErrCodeInvalidTransitGatewayMulticastDomainIDNotFound, "") {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if multicastGroup != nil {
+				return fmt.Errorf("EC2 Transit Gateway Multicast Group (%s) still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccTransitGatewayMulticastGroupBaseConfig() string {
+	return acctest.ConfigCompose(testAccTransitGatewayMulticastDomainAssociationConfig(), `
+resource "aws_network_interface" "test" {
+  subnet_id = aws_subnet.test.id
+
+  tags = {
+    Name = "tf-acc-test-ec2-transit-gateway-multicast"
+  }
+}
+`)
+}
+
+func testAccTransitGatewayMulticastGroupConfig(resourceType string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayMulticastGroupBaseConfig(), fmt.Sprintf(`
+resource %[1]q "test" {
+  group_ip_address                    = "224.0.0.1"
+  network_interface_id                = aws_network_interface.test.id
+  transit_gateway_multicast_domain_id = aws_ec2_transit_gateway_multicast_domain_association.test.transit_gateway_multicast_domain_id
+}
+`, resourceType))
+}
@@ -0,0 +1,147 @@
+package ecs
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceClusterCapacityProviders() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceClusterCapacityProvidersPut,
+		Read:   resourceClusterCapacityProvidersRead,
+		Update: resourceClusterCapacityProvidersPut,
+		Delete: resourceClusterCapacityProvidersDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"capacity_providers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"default_capacity_provider_strategy": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"base": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100000),
+						},
+						"capacity_provider": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceClusterCapacityProvidersPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECSConn
+
+	clusterName := d.Get("cluster_name").(string)
+	input := &ecs.PutClusterCapacityProvidersInput{
+		Cluster:                         aws.String(clusterName),
+		CapacityProviders:               flex.ExpandStringSet(d.Get("capacity_providers").(*schema.Set)),
+		DefaultCapacityProviderStrategy: expandEcsCapacityProviderStrategy(d.Get("default_capacity_provider_strategy").(*schema.Set)),
+	}
+
+	log.Printf("[DEBUG] Putting ECS Cluster Capacity Providers: %s", input)
+	err := retryClusterCapacityProvidersPut(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error putting ECS Cluster (%s) Capacity Providers: %w", clusterName, err)
+	}
+
+	if _, err := waitClusterAvailable(conn, clusterName); err != nil {
+		return fmt.Errorf("error waiting for ECS Cluster (%s) to become Available: %w", clusterName, err)
+	}
+
+	d.SetId(clusterName)
+
+	return resourceClusterCapacityProvidersRead(d, meta)
+}
+
+func resourceClusterCapacityProvidersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECSConn
+
+	cluster, err := FindClusterByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ECS Cluster Capacity Providers (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading ECS Cluster Capacity Providers (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("capacity_providers", aws.StringValueSlice(cluster.CapacityProviders)); err != nil {
+		return fmt.Errorf("error setting capacity_providers: %w", err)
+	}
+
+	d.Set("cluster_name", cluster.ClusterName)
+
+	if err := d.Set("default_capacity_provider_strategy", flattenEcsCapacityProviderStrategy(cluster.DefaultCapacityProviderStrategy)); err != nil {
+		return fmt.Errorf("error setting default_capacity_provider_strategy: %w", err)
+	}
+
+	return nil
+}
+
+func resourceClusterCapacityProvidersDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ECSConn
+
+	// Detaching all capacity providers leaves the cluster free to be deleted.
+	input := &ecs.PutClusterCapacityProvidersInput{
+		Cluster:                         aws.String(d.Id()),
+		CapacityProviders:               []*string{},
+		DefaultCapacityProviderStrategy: []*ecs.CapacityProviderStrategyItem{},
+	}
+
+	log.Printf("[DEBUG] Deleting ECS Cluster Capacity Providers: %s", input)
+	err := retryClusterCapacityProvidersPut(conn, input)
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting ECS Cluster Capacity Providers (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitClusterAvailable(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for ECS Cluster (%s) to become Available: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,205 @@
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ecs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+)
+
+func TestAccECSClusterCapacityProviders_basic(t *testing.T) {
+	var cluster ecs.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_cluster_capacity_providers.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterCapacityProvidersConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists("aws_ecs_cluster.test", &cluster),
+					resource.TestCheckResourceAttr(resourceName, "cluster_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "capacity_providers.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "capacity_providers.*", "FARGATE"),
+					resource.TestCheckResourceAttr(resourceName, "default_capacity_provider_strategy.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "default_capacity_provider_strategy.*", map[string]string{
+						"base":              "1",
+						"weight":            "100",
+						"capacity_provider": "FARGATE",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccECSClusterCapacityProviders_disappears(t *testing.T) {
+	var cluster ecs.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_cluster_capacity_providers.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterCapacityProvidersConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists("aws_ecs_cluster.test", &cluster),
+					acctest.CheckResourceDisappears(acctest.Provider, tfecs.ResourceClusterCapacityProviders(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccECSClusterCapacityProviders_update(t *testing.T) {
+	var cluster ecs.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_cluster_capacity_providers.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterCapacityProvidersConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists("aws_ecs_cluster.test", &cluster),
+					resource.TestCheckResourceAttr(resourceName, "capacity_providers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_capacity_provider_strategy.#", "1"),
+				),
+			},
+			{
+				Config: testAccClusterCapacityProvidersConfig_multiple(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists("aws_ecs_cluster.test", &cluster),
+					resource.TestCheckResourceAttr(resourceName, "capacity_providers.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "capacity_providers.*", "FARGATE"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "capacity_providers.*", "FARGATE_SPOT"),
+					resource.TestCheckResourceAttr(resourceName, "default_capacity_provider_strategy.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "default_capacity_provider_strategy.*", map[string]string{
+						"base":              "0",
+						"weight":            "1",
+						"capacity_provider": "FARGATE_SPOT",
+					}),
+				),
+			},
+			{
+				Config: testAccClusterCapacityProvidersConfig_empty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists("aws_ecs_cluster.test", &cluster),
+					resource.TestCheckResourceAttr(resourceName, "capacity_providers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "default_capacity_provider_strategy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSClusterCapacityProviders_destroy(t *testing.T) {
+	var cluster ecs.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterCapacityProvidersConfig_basic(rName),
+			},
+			{
+				// Removing the resource detaches the capacity providers but keeps the cluster.
+				Config: testAccClusterCapacityProvidersConfig_clusterOnly(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists("aws_ecs_cluster.test", &cluster),
+					func(s *terraform.State) error {
+						if n := len(cluster.CapacityProviders); n != 0 {
+							return fmt.Errorf("expected no capacity providers, got %d", n)
+						}
+
+						if n := len(cluster.DefaultCapacityProviderStrategy); n != 0 {
+							return fmt.Errorf("expected no default capacity provider strategy, got %d", n)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterCapacityProvidersConfig_clusterOnly(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccClusterCapacityProvidersConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterCapacityProvidersConfig_clusterOnly(rName), `
+resource "aws_ecs_cluster_capacity_providers" "test" {
+  cluster_name = aws_ecs_cluster.test.name
+
+  capacity_providers = ["FARGATE"]
+
+  default_capacity_provider_strategy {
+    base              = 1
+    weight            = 100
+    capacity_provider = "FARGATE"
+  }
+}
+`)
+}
+
+func testAccClusterCapacityProvidersConfig_multiple(rName string) string {
+	return acctest.ConfigCompose(testAccClusterCapacityProvidersConfig_clusterOnly(rName), `
+resource "aws_ecs_cluster_capacity_providers" "test" {
+  cluster_name = aws_ecs_cluster.test.name
+
+  capacity_providers = ["FARGATE", "FARGATE_SPOT"]
+
+  default_capacity_provider_strategy {
+    base              = 1
+    weight            = 100
+    capacity_provider = "FARGATE"
+  }
+
+  default_capacity_provider_strategy {
+    weight            = 1
+    capacity_provider = "FARGATE_SPOT"
+  }
+}
+`)
+}
+
+func testAccClusterCapacityProvidersConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccClusterCapacityProvidersConfig_clusterOnly(rName), `
+resource "aws_ecs_cluster_capacity_providers" "test" {
+  cluster_name = aws_ecs_cluster.test.name
+}
+`)
+}
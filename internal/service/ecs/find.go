@@ -1,8 +1,11 @@
 package ecs
 
 import (
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -59,3 +62,137 @@ func FindClusterByNameOrARN(conn *ecs.ECS, nameOrARN string) (*ecs.DescribeClust
 
 	return output, nil
 }
+
+func FindClusterByName(conn *ecs.ECS, name string) (*ecs.Cluster, error) {
+	output, err := FindClusterByNameOrARN(conn, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output.Clusters) == 0 || output.Clusters[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message: "Empty result",
+		}
+	}
+
+	cluster := output.Clusters[0]
+
+	// Status==INACTIVE means deleted cluster
+	if status := aws.StringValue(cluster.Status); status == "INACTIVE" {
+		return nil, &resource.NotFoundError{
+			Message: status,
+		}
+	}
+
+	return cluster, nil
+}
+
+func FindServiceByIDAndCluster(conn *ecs.ECS, id, cluster string) (*ecs.Service, error) {
+	input := &ecs.DescribeServicesInput{
+		Services: aws.StringSlice([]string{id}),
+	}
+
+	if cluster != "" {
+		input.Cluster = aws.String(cluster)
+	}
+
+	output, err := conn.DescribeServices(input)
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException, ecs.ErrCodeServiceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Services) == 0 || output.Services[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	service := output.Services[0]
+
+	if status := aws.StringValue(service.Status); status == serviceStatusInactive {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return service, nil
+}
+
+// findServicePrimaryDeployment returns the service's PRIMARY deployment, i.e. the most recent one.
+func findServicePrimaryDeployment(service *ecs.Service) (*ecs.Deployment, error) {
+	for _, deployment := range service.Deployments {
+		if aws.StringValue(deployment.Status) == serviceDeploymentStatusPrimary {
+			return deployment, nil
+		}
+	}
+
+	return nil, &resource.NotFoundError{
+		Message: fmt.Sprintf("ECS service (%s) has no %s deployment", aws.StringValue(service.ServiceArn), serviceDeploymentStatusPrimary),
+	}
+}
+
+// findServiceDeploymentStoppedTaskReasons returns the distinct reasons tasks started by the deployment were stopped.
+func findServiceDeploymentStoppedTaskReasons(conn *ecs.ECS, cluster, deploymentID string) ([]string, error) {
+	input := &ecs.ListTasksInput{
+		DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+		StartedBy:     aws.String(deploymentID),
+	}
+
+	if cluster != "" {
+		input.Cluster = aws.String(cluster)
+	}
+
+	output, err := conn.ListTasks(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TaskArns) == 0 {
+		return nil, nil
+	}
+
+	describeInput := &ecs.DescribeTasksInput{
+		Tasks: output.TaskArns,
+	}
+
+	if cluster != "" {
+		describeInput.Cluster = aws.String(cluster)
+	}
+
+	describeOutput, err := conn.DescribeTasks(describeInput)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var reasons []string
+	seen := make(map[string]bool)
+
+	for _, task := range describeOutput.Tasks {
+		reason := aws.StringValue(task.StoppedReason)
+
+		for _, container := range task.Containers {
+			if v := aws.StringValue(container.Reason); v != "" {
+				reason = fmt.Sprintf("%s (container %s: %s)", reason, aws.StringValue(container.Name), v)
+			}
+		}
+
+		if reason == "" || seen[reason] {
+			continue
+		}
+
+		seen[reason] = true
+		reasons = append(reasons, reason)
+	}
+
+	return reasons, nil
+}
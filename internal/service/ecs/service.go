@@ -37,6 +37,8 @@ func ResourceService() *schema.Resource {
 		},
 
 		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
@@ -371,6 +373,11 @@ func ResourceService() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"wait_for_rollout": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"wait_for_steady_state": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -433,6 +440,7 @@ func resourceServiceImport(d *schema.ResourceData, meta interface{}) ([]*schema.
 		Resource:  fmt.Sprintf("cluster/%s", cluster),
 	}.String()
 	d.Set("cluster", clusterArn)
+	d.Set("wait_for_rollout", false)
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -611,6 +619,12 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error creating %s service: %w", d.Get("name").(string), err)
 	}
 
+	if d.Get("wait_for_rollout").(bool) {
+		if _, err := waitServiceDeploymentRolloutCompleted(conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for ECS service (%s) deployment to complete: %w", d.Id(), err)
+		}
+	}
+
 	if d.Get("wait_for_steady_state").(bool) {
 		cluster := ""
 		if v, ok := d.GetOk("cluster"); ok {
@@ -1187,6 +1201,12 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.Get("wait_for_rollout").(bool) {
+		if _, err := waitServiceDeploymentRolloutCompleted(conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error waiting for ECS service (%s) deployment to complete: %w", d.Id(), err)
+		}
+	}
+
 	if d.Get("wait_for_steady_state").(bool) {
 		cluster := ""
 		if v, ok := d.GetOk("cluster"); ok {
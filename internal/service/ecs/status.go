@@ -1,6 +1,7 @@
 package ecs
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -25,6 +26,8 @@ const (
 	taskSetStatusActive   = "ACTIVE"
 	taskSetStatusDraining = "DRAINING"
 	taskSetStatusPrimary  = "PRIMARY"
+
+	serviceDeploymentStatusPrimary = "PRIMARY"
 )
 
 func statusCapacityProvider(conn *ecs.ECS, arn string) resource.StateRefreshFunc {
@@ -148,3 +151,31 @@ func statusTaskSet(conn *ecs.ECS, taskSetID, service, cluster string) resource.S
 		return output.TaskSets[0], aws.StringValue(output.TaskSets[0].Status), nil
 	}
 }
+
+// statusServiceDeploymentRollout returns the rollout state of the specified deployment.
+// An error is returned if the deployment has been superseded, e.g. by a circuit breaker rollback.
+func statusServiceDeploymentRollout(conn *ecs.ECS, id, cluster, deploymentID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		service, err := FindServiceByIDAndCluster(conn, id, cluster)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, deployment := range service.Deployments {
+			if aws.StringValue(deployment.Id) != deploymentID {
+				continue
+			}
+
+			state := aws.StringValue(deployment.RolloutState)
+
+			if state != ecs.DeploymentRolloutStateFailed && aws.StringValue(deployment.Status) != serviceDeploymentStatusPrimary {
+				return deployment, "", fmt.Errorf("deployment (%s) was superseded by a newer deployment before completing", deploymentID)
+			}
+
+			return deployment, state, nil
+		}
+
+		return nil, "", fmt.Errorf("deployment (%s) not found, it may have been rolled back", deploymentID)
+	}
+}
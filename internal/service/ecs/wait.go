@@ -1,11 +1,16 @@
 package ecs
 
 import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -18,6 +23,8 @@ const (
 	serviceDescribeTimeout    = 2 * time.Minute
 	serviceUpdateTimeout      = 2 * time.Minute
 
+	serviceDeploymentRolloutMinTimeout = 15 * time.Second
+
 	clusterAvailableTimeout = 10 * time.Minute
 	clusterDeleteTimeout    = 10 * time.Minute
 	clusterAvailableDelay   = 10 * time.Second
@@ -182,3 +189,64 @@ func waitTaskSetDeleted(conn *ecs.ECS, taskSetID, service, cluster string) error
 
 	return err
 }
+
+// waitServiceDeploymentRolloutCompleted waits for the service's PRIMARY deployment to finish rolling out.
+// If the deployment fails or is rolled back by the deployment circuit breaker, the returned error
+// includes the reasons the deployment's tasks were stopped.
+func waitServiceDeploymentRolloutCompleted(conn *ecs.ECS, id, cluster string, timeout time.Duration) (*ecs.Deployment, error) {
+	service, err := FindServiceByIDAndCluster(conn, id, cluster)
+
+	if err != nil {
+		return nil, err
+	}
+
+	deployment, err := findServicePrimaryDeployment(service)
+
+	if err != nil {
+		return nil, err
+	}
+
+	deploymentID := aws.StringValue(deployment.Id)
+
+	// Services behind a Classic Load Balancer or using an external deployment controller report no rollout state.
+	if deployment.RolloutState == nil {
+		log.Printf("[WARN] ECS service (%s) deployment (%s) has no rollout state, not waiting", id, deploymentID)
+		return deployment, nil
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ecs.DeploymentRolloutStateInProgress},
+		Target:     []string{ecs.DeploymentRolloutStateCompleted},
+		Refresh:    statusServiceDeploymentRollout(conn, id, cluster, deploymentID),
+		Timeout:    timeout,
+		MinTimeout: serviceDeploymentRolloutMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if err == nil {
+		if output, ok := outputRaw.(*ecs.Deployment); ok {
+			return output, nil
+		}
+
+		return nil, nil
+	}
+
+	if output, ok := outputRaw.(*ecs.Deployment); ok {
+		if reason := aws.StringValue(output.RolloutStateReason); reason != "" {
+			tfresource.SetLastError(err, errors.New(reason))
+		}
+	}
+
+	reasons, stoppedErr := findServiceDeploymentStoppedTaskReasons(conn, cluster, deploymentID)
+
+	if stoppedErr != nil {
+		log.Printf("[WARN] Error listing ECS service (%s) deployment (%s) stopped tasks: %s", id, deploymentID, stoppedErr)
+	}
+
+	if len(reasons) > 0 {
+		err = fmt.Errorf("%w\nstopped tasks:\n  %s", err, strings.Join(reasons, "\n  "))
+	}
+
+	return nil, err
+}
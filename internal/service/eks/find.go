@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -185,6 +186,45 @@ func FindNodegroupByClusterNameAndNodegroupName(conn *eks.EKS, clusterName, node
 	return output.Nodegroup, nil
 }
 
+// FindNodegroupAutoScalingGroups returns the Auto Scaling groups backing the specified node group.
+func FindNodegroupAutoScalingGroups(conn *eks.EKS, autoscalingConn *autoscaling.AutoScaling, clusterName, nodeGroupName string) ([]*autoscaling.Group, error) {
+	nodeGroup, err := FindNodegroupByClusterNameAndNodegroupName(conn, clusterName, nodeGroupName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if nodeGroup.Resources == nil || len(nodeGroup.Resources.AutoScalingGroups) == 0 {
+		return nil, nil
+	}
+
+	input := &autoscaling.DescribeAutoScalingGroupsInput{}
+
+	for _, v := range nodeGroup.Resources.AutoScalingGroups {
+		if v != nil && v.Name != nil {
+			input.AutoScalingGroupNames = append(input.AutoScalingGroupNames, v.Name)
+		}
+	}
+
+	var output []*autoscaling.Group
+
+	err = autoscalingConn.DescribeAutoScalingGroupsPages(input, func(page *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.AutoScalingGroups...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func FindNodegroupUpdateByClusterNameNodegroupNameAndID(conn *eks.EKS, clusterName, nodeGroupName, id string) (*eks.Update, error) {
 	input := &eks.DescribeUpdateInput{
 		Name:          aws.String(clusterName),
@@ -2,8 +2,10 @@ package eks
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -468,6 +470,7 @@ func resourceNodeGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 
 func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EKSConn
+	autoscalingConn := meta.(*conns.AWSClient).AutoScalingConn
 
 	clusterName, nodeGroupName, err := NodeGroupParseResourceID(d.Id())
 
@@ -520,10 +523,10 @@ func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 		updateID := aws.StringValue(output.Update.Id)
 
-		_, err = waitNodegroupUpdateSuccessful(ctx, conn, clusterName, nodeGroupName, updateID, d.Timeout(schema.TimeoutUpdate))
+		update, err := waitNodegroupUpdateSuccessful(ctx, conn, autoscalingConn, clusterName, nodeGroupName, updateID, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
-			return diag.Errorf("error waiting for EKS Node Group (%s) version update (%s): %s", d.Id(), updateID, err)
+			return nodegroupUpdateErrorDiagnostics(d.Id(), "version", updateID, update, !d.Get("force_update_version").(bool), err)
 		}
 	}
 
@@ -559,10 +562,10 @@ func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 		updateID := aws.StringValue(output.Update.Id)
 
-		_, err = waitNodegroupUpdateSuccessful(ctx, conn, clusterName, nodeGroupName, updateID, d.Timeout(schema.TimeoutUpdate))
+		update, err := waitNodegroupUpdateSuccessful(ctx, conn, autoscalingConn, clusterName, nodeGroupName, updateID, d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
-			return diag.Errorf("error waiting for EKS Node Group (%s) config update (%s): %s", d.Id(), updateID, err)
+			return nodegroupUpdateErrorDiagnostics(d.Id(), "config", updateID, update, false, err)
 		}
 	}
 
@@ -608,6 +611,44 @@ func resourceNodeGroupDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return nil
 }
 
+// nodegroupUpdateErrorDiagnostics describes a failed node group update, including the update's ID,
+// parameters and errors. If nodes could not be drained and the update was not forced, it suggests
+// setting force_update_version.
+func nodegroupUpdateErrorDiagnostics(id, updateType, updateID string, update *eks.Update, suggestForce bool, err error) diag.Diagnostics {
+	detail := err.Error()
+
+	if update != nil {
+		var params []string
+
+		for _, v := range update.Params {
+			if v == nil {
+				continue
+			}
+
+			params = append(params, fmt.Sprintf("%s=%s", aws.StringValue(v.Type), aws.StringValue(v.Value)))
+		}
+
+		if len(params) > 0 {
+			detail += fmt.Sprintf("\n\nUpdate parameters: %s", strings.Join(params, ", "))
+		}
+
+		for _, v := range update.Errors {
+			if suggestForce && v != nil && aws.StringValue(v.ErrorCode) == eks.ErrorCodePodEvictionFailure {
+				detail += "\n\nPods could not be evicted from the existing nodes, typically because of a PodDisruptionBudget. Set force_update_version = true to replace the nodes regardless."
+				break
+			}
+		}
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("error waiting for EKS Node Group (%s) %s update (%s)", id, updateType, updateID),
+			Detail:   detail,
+		},
+	}
+}
+
 func expandEksLaunchTemplateSpecification(l []interface{}) *eks.LaunchTemplateSpecification {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -0,0 +1,82 @@
+package eks
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestNodegroupUpdateErrorDiagnostics(t *testing.T) {
+	podEvictionFailure := &eks.Update{
+		Errors: []*eks.ErrorDetail{
+			{
+				ErrorCode:    aws.String(eks.ErrorCodePodEvictionFailure),
+				ErrorMessage: aws.String("Reached max retries while trying to evict pods from nodes in node group"),
+			},
+		},
+		Id: aws.String("update-id"),
+		Params: []*eks.UpdateParam{
+			{
+				Type:  aws.String(eks.UpdateParamTypeLaunchTemplateVersion),
+				Value: aws.String("2"),
+			},
+		},
+		Status: aws.String(eks.UpdateStatusFailed),
+	}
+
+	testCases := []struct {
+		TestName           string
+		Update             *eks.Update
+		SuggestForce       bool
+		ExpectedDetail     []string
+		UnexpectedInDetail []string
+	}{
+		{
+			TestName:           "no update",
+			ExpectedDetail:     []string{"unexpected state 'Failed'"},
+			UnexpectedInDetail: []string{"Update parameters", "force_update_version"},
+		},
+		{
+			TestName:       "pod eviction failure",
+			Update:         podEvictionFailure,
+			SuggestForce:   true,
+			ExpectedDetail: []string{"Update parameters: LaunchTemplateVersion=2", "force_update_version = true"},
+		},
+		{
+			TestName:           "pod eviction failure already forced",
+			Update:             podEvictionFailure,
+			ExpectedDetail:     []string{"Update parameters: LaunchTemplateVersion=2"},
+			UnexpectedInDetail: []string{"force_update_version"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			diags := nodegroupUpdateErrorDiagnostics("cluster:nodegroup", "version", "update-id", testCase.Update, testCase.SuggestForce, errors.New("unexpected state 'Failed', wanted target 'Successful'"))
+
+			if len(diags) != 1 || diags[0].Severity != diag.Error {
+				t.Fatalf("expected a single error diagnostic, got %#v", diags)
+			}
+
+			if got, want := diags[0].Summary, "error waiting for EKS Node Group (cluster:nodegroup) version update (update-id)"; got != want {
+				t.Errorf("got summary %q, expected %q", got, want)
+			}
+
+			for _, v := range testCase.ExpectedDetail {
+				if !strings.Contains(diags[0].Detail, v) {
+					t.Errorf("expected detail to contain %q, got %q", v, diags[0].Detail)
+				}
+			}
+
+			for _, v := range testCase.UnexpectedInDetail {
+				if strings.Contains(diags[0].Detail, v) {
+					t.Errorf("expected detail not to contain %q, got %q", v, diags[0].Detail)
+				}
+			}
+		})
+	}
+}
@@ -2,9 +2,11 @@ package eks
 
 import (
 	"context"
+	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -210,11 +212,21 @@ func waitNodegroupDeleted(ctx context.Context, conn *eks.EKS, clusterName, nodeG
 	return nil, err
 }
 
-func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.EKS, clusterName, nodeGroupName, id string, timeout time.Duration) (*eks.Update, error) { //nolint:unparam
+func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.EKS, autoscalingConn *autoscaling.AutoScaling, clusterName, nodeGroupName, id string, timeout time.Duration) (*eks.Update, error) { //nolint:unparam
+	refresh := statusNodegroupUpdate(conn, clusterName, nodeGroupName, id)
+
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{eks.UpdateStatusInProgress},
 		Target:  []string{eks.UpdateStatusSuccessful},
-		Refresh: statusNodegroupUpdate(conn, clusterName, nodeGroupName, id),
+		Refresh: func() (interface{}, string, error) {
+			output, status, err := refresh()
+
+			if err == nil && status == eks.UpdateStatusInProgress {
+				logNodegroupUpdateProgress(conn, autoscalingConn, clusterName, nodeGroupName, id)
+			}
+
+			return output, status, err
+		},
 		Timeout: timeout,
 	}
 
@@ -231,6 +243,45 @@ func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.EKS, clusterNa
 	return nil, err
 }
 
+// logNodegroupUpdateProgress logs how many of the node group's nodes have been replaced so far.
+// EKS does not report rolling update progress, so the node counts are read from the node group's Auto Scaling groups.
+// Any error is logged and otherwise ignored.
+func logNodegroupUpdateProgress(conn *eks.EKS, autoscalingConn *autoscaling.AutoScaling, clusterName, nodeGroupName, id string) {
+	groups, err := FindNodegroupAutoScalingGroups(conn, autoscalingConn, clusterName, nodeGroupName)
+
+	if err != nil {
+		log.Printf("[WARN] Error reading EKS Node Group (%s/%s) Auto Scaling groups: %s", clusterName, nodeGroupName, err)
+		return
+	}
+
+	var desired, total, inService, updated int
+
+	for _, group := range groups {
+		desired += int(aws.Int64Value(group.DesiredCapacity))
+
+		launchTemplate := group.LaunchTemplate
+		if launchTemplate == nil && group.MixedInstancesPolicy != nil && group.MixedInstancesPolicy.LaunchTemplate != nil {
+			launchTemplate = group.MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification
+		}
+
+		for _, instance := range group.Instances {
+			total++
+
+			if aws.StringValue(instance.LifecycleState) == autoscaling.LifecycleStateInService {
+				inService++
+			}
+
+			if launchTemplate != nil && instance.LaunchTemplate != nil &&
+				aws.StringValue(instance.LaunchTemplate.LaunchTemplateId) == aws.StringValue(launchTemplate.LaunchTemplateId) &&
+				aws.StringValue(instance.LaunchTemplate.Version) == aws.StringValue(launchTemplate.Version) {
+				updated++
+			}
+		}
+	}
+
+	log.Printf("[INFO] EKS Node Group (%s/%s) update (%s) in progress: %d of %d nodes updated, %d InService, %d desired", clusterName, nodeGroupName, id, updated, total, inService, desired)
+}
+
 func waitOIDCIdentityProviderConfigCreated(ctx context.Context, conn *eks.EKS, clusterName, configName string, timeout time.Duration) (*eks.OidcIdentityProviderConfig, error) {
 	stateConf := resource.StateChangeConf{
 		Pending: []string{eks.ConfigStatusCreating},
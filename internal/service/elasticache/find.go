@@ -226,3 +226,61 @@ func FindElastiCacheUserGroupByID(conn *elasticache.ElastiCache, groupID string)
 		}
 	}
 }
+
+// FindCacheSubnetGroupByName retrieves an ElastiCache Subnet Group by name.
+func FindCacheSubnetGroupByName(conn *elasticache.ElastiCache, name string) (*elasticache.CacheSubnetGroup, error) {
+	input := &elasticache.DescribeCacheSubnetGroupsInput{
+		CacheSubnetGroupName: aws.String(name),
+	}
+	output, err := conn.DescribeCacheSubnetGroups(input)
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeCacheSubnetGroupNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.CacheSubnetGroups) == 0 || output.CacheSubnetGroups[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.CacheSubnetGroups[0], nil
+}
+
+// FindReservedCacheNodesOfferings retrieves all ElastiCache Reserved Cache Node Offerings matching the input.
+func FindReservedCacheNodesOfferings(conn *elasticache.ElastiCache, input *elasticache.DescribeReservedCacheNodesOfferingsInput) ([]*elasticache.ReservedCacheNodesOffering, error) {
+	var output []*elasticache.ReservedCacheNodesOffering
+
+	err := conn.DescribeReservedCacheNodesOfferingsPages(input, func(page *elasticache.DescribeReservedCacheNodesOfferingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ReservedCacheNodesOfferings {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeReservedCacheNodesOfferingNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
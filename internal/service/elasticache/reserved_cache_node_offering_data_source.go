@@ -0,0 +1,102 @@
+package elasticache
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	// secondsPerYear is the number of seconds in the 365-day year used by
+	// the Reserved Cache Node duration.
+	secondsPerYear = 31536000
+)
+
+func DataSourceReservedCacheNodeOffering() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceReservedCacheNodeOfferingRead,
+
+		Schema: map[string]*schema.Schema{
+			"cache_node_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"duration": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntInSlice([]int{1, 3}),
+			},
+			"fixed_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"offering_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"offering_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Light Utilization",
+					"Medium Utilization",
+					"Heavy Utilization",
+					"Partial Upfront",
+					"All Upfront",
+					"No Upfront",
+				}, false),
+			},
+			"product_description": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"usage_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceReservedCacheNodeOfferingRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ElastiCacheConn
+
+	input := &elasticache.DescribeReservedCacheNodesOfferingsInput{
+		CacheNodeType:      aws.String(d.Get("cache_node_type").(string)),
+		Duration:           aws.String(strconv.Itoa(d.Get("duration").(int) * secondsPerYear)),
+		OfferingType:       aws.String(d.Get("offering_type").(string)),
+		ProductDescription: aws.String(d.Get("product_description").(string)),
+	}
+
+	offerings, err := FindReservedCacheNodesOfferings(conn, input)
+
+	if err != nil {
+		return fmt.Errorf("error reading ElastiCache Reserved Cache Node Offerings: %w", err)
+	}
+
+	if len(offerings) == 0 {
+		return fmt.Errorf("no ElastiCache Reserved Cache Node Offerings matched; change your search criteria and try again")
+	}
+
+	if len(offerings) > 1 {
+		return fmt.Errorf("%d ElastiCache Reserved Cache Node Offerings matched; use additional constraints to reduce matches to a single offering", len(offerings))
+	}
+
+	offering := offerings[0]
+
+	d.SetId(aws.StringValue(offering.ReservedCacheNodesOfferingId))
+	d.Set("cache_node_type", offering.CacheNodeType)
+	d.Set("duration", aws.Int64Value(offering.Duration)/secondsPerYear)
+	d.Set("fixed_price", offering.FixedPrice)
+	d.Set("offering_id", offering.ReservedCacheNodesOfferingId)
+	d.Set("offering_type", offering.OfferingType)
+	d.Set("product_description", offering.ProductDescription)
+	d.Set("usage_price", offering.UsagePrice)
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package elasticache_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccElastiCacheReservedCacheNodeOfferingDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_elasticache_reserved_cache_node_offering.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		Providers:  acctest.Providers,
+		ErrorCheck: acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReservedCacheNodeOfferingDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cache_node_type", "cache.t4g.small"),
+					resource.TestCheckResourceAttr(dataSourceName, "duration", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "fixed_price"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "offering_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "offering_type", "No Upfront"),
+					resource.TestCheckResourceAttr(dataSourceName, "product_description", "redis"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "usage_price"),
+				),
+			},
+		},
+	})
+}
+
+const testAccReservedCacheNodeOfferingDataSourceConfig = `
+data "aws_elasticache_reserved_cache_node_offering" "test" {
+  cache_node_type     = "cache.t4g.small"
+  duration            = 1
+  offering_type       = "No Upfront"
+  product_description = "redis"
+}
+`
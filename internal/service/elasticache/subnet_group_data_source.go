@@ -0,0 +1,79 @@
+package elasticache
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceSubnetGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSubnetGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSubnetGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ElastiCacheConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	name := d.Get("name").(string)
+
+	group, err := FindCacheSubnetGroupByName(conn, name)
+
+	if err != nil {
+		return fmt.Errorf("error reading ElastiCache Subnet Group (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(group.CacheSubnetGroupName))
+
+	var subnetIDs []string
+	for _, v := range group.Subnets {
+		subnetIDs = append(subnetIDs, aws.StringValue(v.SubnetIdentifier))
+	}
+
+	d.Set("arn", group.ARN)
+	d.Set("description", group.CacheSubnetGroupDescription)
+	d.Set("name", group.CacheSubnetGroupName)
+	d.Set("subnet_ids", subnetIDs)
+	d.Set("vpc_id", group.VpcId)
+
+	tags, err := ListTags(conn, aws.StringValue(group.ARN))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for ElastiCache Subnet Group (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
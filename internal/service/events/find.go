@@ -37,6 +37,34 @@ func FindConnectionByName(conn *eventbridge.EventBridge, name string) (*eventbri
 	return output, nil
 }
 
+func FindReplayByName(conn *eventbridge.EventBridge, name string) (*eventbridge.DescribeReplayOutput, error) {
+	input := &eventbridge.DescribeReplayInput{
+		ReplayName: aws.String(name),
+	}
+
+	output, err := conn.DescribeReplay(input)
+
+	if tfawserr.ErrCodeEquals(err, eventbridge.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func FindRuleByEventBusAndRuleNames(conn *eventbridge.EventBridge, eventBusName, ruleName string) (*eventbridge.DescribeRuleOutput, error) {
 	input := eventbridge.DescribeRuleInput{
 		Name: aws.String(ruleName),
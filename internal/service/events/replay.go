@@ -0,0 +1,264 @@
+package events
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceReplay() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceReplayCreate,
+		Read:   resourceReplayRead,
+		Delete: resourceReplayDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"destination": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"filter_arns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+			},
+			"event_end_time": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: verify.SuppressEquivalentRFC3339Time,
+			},
+			"event_last_replayed_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"event_source_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"event_start_time": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: verify.SuppressEquivalentRFC3339Time,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validReplayName,
+			},
+			"replay_end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"replay_start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceReplayCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EventsConn
+
+	name := d.Get("name").(string)
+	eventStartTime, _ := time.Parse(time.RFC3339, d.Get("event_start_time").(string))
+	eventEndTime, _ := time.Parse(time.RFC3339, d.Get("event_end_time").(string))
+	input := &eventbridge.StartReplayInput{
+		Destination:    expandReplayDestination(d.Get("destination").([]interface{})),
+		EventEndTime:   aws.Time(eventEndTime),
+		EventSourceArn: aws.String(d.Get("event_source_arn").(string)),
+		EventStartTime: aws.Time(eventStartTime),
+		ReplayName:     aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Starting EventBridge Replay: %s", input)
+	_, err := conn.StartReplay(input)
+
+	if err != nil {
+		return fmt.Errorf("error starting EventBridge Replay (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitReplayCompleted(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EventBridge Replay (%s) to complete: %w", d.Id(), err)
+	}
+
+	return resourceReplayRead(d, meta)
+}
+
+func resourceReplayRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EventsConn
+
+	output, err := FindReplayByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EventBridge Replay (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EventBridge Replay (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", output.ReplayArn)
+	d.Set("description", output.Description)
+	if err := d.Set("destination", flattenReplayDestination(output.Destination)); err != nil {
+		return fmt.Errorf("error setting destination: %w", err)
+	}
+	d.Set("event_end_time", flattenReplayTime(output.EventEndTime))
+	d.Set("event_last_replayed_time", flattenReplayTime(output.EventLastReplayedTime))
+	d.Set("event_source_arn", output.EventSourceArn)
+	d.Set("event_start_time", flattenReplayTime(output.EventStartTime))
+	d.Set("name", output.ReplayName)
+	d.Set("replay_end_time", flattenReplayTime(output.ReplayEndTime))
+	d.Set("replay_start_time", flattenReplayTime(output.ReplayStartTime))
+	d.Set("state", output.State)
+	d.Set("state_reason", output.StateReason)
+
+	return nil
+}
+
+func resourceReplayDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EventsConn
+
+	// Replays cannot be deleted. Cancel the replay if it is still in progress.
+	output, err := FindReplayByName(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EventBridge Replay (%s): %w", d.Id(), err)
+	}
+
+	switch aws.StringValue(output.State) {
+	case eventbridge.ReplayStateStarting, eventbridge.ReplayStateRunning:
+	default:
+		return nil
+	}
+
+	log.Printf("[DEBUG] Cancelling EventBridge Replay: %s", d.Id())
+	_, err = conn.CancelReplay(&eventbridge.CancelReplayInput{
+		ReplayName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, eventbridge.ErrCodeResourceNotFoundException, eventbridge.ErrCodeIllegalStatusException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error cancelling EventBridge Replay (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitReplayCancelled(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EventBridge Replay (%s) to cancel: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandReplayDestination(tfList []interface{}) *eventbridge.ReplayDestination {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &eventbridge.ReplayDestination{}
+
+	if v, ok := tfMap["arn"].(string); ok && v != "" {
+		apiObject.Arn = aws.String(v)
+	}
+
+	if v, ok := tfMap["filter_arns"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.FilterArns = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenReplayDestination(apiObject *eventbridge.ReplayDestination) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"arn":         aws.StringValue(apiObject.Arn),
+		"filter_arns": aws.StringValueSlice(apiObject.FilterArns),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenReplayTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return aws.TimeValue(t).Format(time.RFC3339)
+}
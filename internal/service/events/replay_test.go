@@ -0,0 +1,124 @@
+package events_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfevents "github.com/hashicorp/terraform-provider-aws/internal/service/events"
+)
+
+func TestAccEventsReplay_basic(t *testing.T) {
+	var v eventbridge.DescribeReplayOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_replay.test"
+	// The replayed time window must start after the archive is created and end before the replay starts.
+	startTime := time.Now().UTC().Add(1 * time.Minute).Truncate(time.Second)
+	endTime := startTime.Add(1 * time.Minute)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, eventbridge.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckArchiveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReplayConfig_base(rName),
+			},
+			{
+				PreConfig: func() { time.Sleep(time.Until(endTime)) },
+				Config:    testAccReplayConfig_basic(rName, startTime, endTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplayExists(resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "events", fmt.Sprintf("replay/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "description", "test replay"),
+					resource.TestCheckResourceAttr(resourceName, "destination.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination.0.arn", "aws_cloudwatch_event_bus.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.filter_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "event_end_time", endTime.Format(time.RFC3339)),
+					resource.TestCheckResourceAttrPair(resourceName, "event_source_arn", "aws_cloudwatch_event_archive.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "event_start_time", startTime.Format(time.RFC3339)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "replay_end_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "replay_start_time"),
+					resource.TestCheckResourceAttr(resourceName, "state", eventbridge.ReplayStateCompleted),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckReplayExists(n string, v *eventbridge.DescribeReplayOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EventBridge Replay ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EventsConn
+
+		output, err := tfevents.FindReplayByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccReplayConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_event_archive" "test" {
+  name             = %[1]q
+  event_source_arn = aws_cloudwatch_event_bus.test.arn
+}
+
+resource "aws_cloudwatch_event_rule" "test" {
+  name           = %[1]q
+  event_bus_name = aws_cloudwatch_event_bus.test.name
+
+  event_pattern = jsonencode({
+    source = ["test"]
+  })
+}
+`, rName)
+}
+
+func testAccReplayConfig_basic(rName string, startTime, endTime time.Time) string {
+	return acctest.ConfigCompose(testAccReplayConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_event_replay" "test" {
+  name             = %[1]q
+  description      = "test replay"
+  event_source_arn = aws_cloudwatch_event_archive.test.arn
+  event_start_time = %[2]q
+  event_end_time   = %[3]q
+
+  destination {
+    arn         = aws_cloudwatch_event_bus.test.arn
+    filter_arns = [aws_cloudwatch_event_rule.test.arn]
+  }
+}
+`, rName, startTime.Format(time.RFC3339), endTime.Format(time.RFC3339)))
+}
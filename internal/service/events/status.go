@@ -22,3 +22,19 @@ func statusConnectionState(conn *eventbridge.EventBridge, name string) resource.
 		return output, aws.StringValue(output.ConnectionState), nil
 	}
 }
+
+func statusReplayState(conn *eventbridge.EventBridge, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindReplayByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
@@ -1,10 +1,14 @@
 package events
 
 import (
+	"errors"
+	"log"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -63,3 +67,72 @@ func waitConnectionUpdated(conn *eventbridge.EventBridge, id string) (*eventbrid
 
 	return nil, err
 }
+
+func waitReplayCompleted(conn *eventbridge.EventBridge, name string, timeout time.Duration) (*eventbridge.DescribeReplayOutput, error) {
+	refresh := statusReplayState(conn, name)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eventbridge.ReplayStateStarting, eventbridge.ReplayStateRunning},
+		Target:  []string{eventbridge.ReplayStateCompleted},
+		Refresh: func() (interface{}, string, error) {
+			outputRaw, state, err := refresh()
+
+			if output, ok := outputRaw.(*eventbridge.DescribeReplayOutput); ok {
+				logReplayProgress(output)
+			}
+
+			return outputRaw, state, err
+		},
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*eventbridge.DescribeReplayOutput); ok {
+		if reason := aws.StringValue(output.StateReason); reason != "" {
+			tfresource.SetLastError(err, errors.New(reason))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitReplayCancelled(conn *eventbridge.EventBridge, name string, timeout time.Duration) (*eventbridge.DescribeReplayOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{eventbridge.ReplayStateStarting, eventbridge.ReplayStateRunning, eventbridge.ReplayStateCancelling},
+		Target:  []string{eventbridge.ReplayStateCancelled, eventbridge.ReplayStateCompleted, eventbridge.ReplayStateFailed},
+		Refresh: statusReplayState(conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*eventbridge.DescribeReplayOutput); ok {
+		if reason := aws.StringValue(output.StateReason); reason != "" {
+			tfresource.SetLastError(err, errors.New(reason))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// logReplayProgress logs how far through its event time window a running replay has got.
+func logReplayProgress(output *eventbridge.DescribeReplayOutput) {
+	if output.EventLastReplayedTime == nil || output.EventStartTime == nil || output.EventEndTime == nil {
+		return
+	}
+
+	start := aws.TimeValue(output.EventStartTime)
+	window := aws.TimeValue(output.EventEndTime).Sub(start)
+
+	if window <= 0 {
+		return
+	}
+
+	progress := float64(aws.TimeValue(output.EventLastReplayedTime).Sub(start)) / float64(window) * 100
+
+	log.Printf("[DEBUG] EventBridge Replay (%s) %s: %.0f%% of events replayed (last replayed event time %s)", aws.StringValue(output.ReplayName), aws.StringValue(output.State), progress, aws.TimeValue(output.EventLastReplayedTime).Format(time.RFC3339))
+}
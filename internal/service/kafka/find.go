@@ -33,6 +33,31 @@ func FindClusterByARN(conn *kafka.Kafka, arn string) (*kafka.ClusterInfo, error)
 	return output.ClusterInfo, nil
 }
 
+func FindClusterV2ByARN(conn *kafka.Kafka, arn string) (*kafka.Cluster, error) {
+	input := &kafka.DescribeClusterV2Input{
+		ClusterArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeClusterV2(input)
+
+	if tfawserr.ErrCodeEquals(err, kafka.ErrCodeNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ClusterInfo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ClusterInfo, nil
+}
+
 func FindClusterOperationByARN(conn *kafka.Kafka, arn string) (*kafka.ClusterOperationInfo, error) {
 	input := &kafka.DescribeClusterOperationInput{
 		ClusterOperationArn: aws.String(arn),
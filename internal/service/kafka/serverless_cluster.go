@@ -0,0 +1,332 @@
+package kafka
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafka"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceServerlessCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServerlessClusterCreate,
+		Read:   resourceServerlessClusterRead,
+		Update: resourceServerlessClusterUpdate,
+		Delete: resourceServerlessClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(clusterCreateDefaultTimeout),
+			Delete: schema.DefaultTimeout(clusterDeleteDefaultTimeout),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bootstrap_brokers_sasl_iam": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_authentication": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sasl": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"iam": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enabled": {
+													Type:     schema.TypeBool,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 5,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceServerlessClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KafkaConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("cluster_name").(string)
+	input := &kafka.CreateClusterV2Input{
+		ClusterName: aws.String(name),
+		Serverless: &kafka.ServerlessRequest{
+			ClientAuthentication: expandServerlessClientAuthentication(d.Get("client_authentication").([]interface{})),
+			VpcConfigs:           expandVpcConfigs(d.Get("vpc_config").([]interface{})),
+		},
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating MSK Serverless Cluster: %s", input)
+	output, err := conn.CreateClusterV2(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MSK Serverless Cluster (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ClusterArn))
+
+	_, err = waitClusterV2Created(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for MSK Serverless Cluster (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceServerlessClusterRead(d, meta)
+}
+
+func resourceServerlessClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KafkaConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	cluster, err := FindClusterV2ByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MSK Serverless Cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MSK Serverless Cluster (%s): %w", d.Id(), err)
+	}
+
+	if cluster.Serverless == nil {
+		return fmt.Errorf("error reading MSK Serverless Cluster (%s): cluster type is %s", d.Id(), aws.StringValue(cluster.ClusterType))
+	}
+
+	output, err := conn.GetBootstrapBrokers(&kafka.GetBootstrapBrokersInput{
+		ClusterArn: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading MSK Serverless Cluster (%s) bootstrap brokers: %w", d.Id(), err)
+	}
+
+	d.Set("arn", cluster.ClusterArn)
+	d.Set("bootstrap_brokers_sasl_iam", SortEndpointsString(aws.StringValue(output.BootstrapBrokerStringSaslIam)))
+
+	if err := d.Set("client_authentication", flattenServerlessClientAuthentication(cluster.Serverless.ClientAuthentication)); err != nil {
+		return fmt.Errorf("error setting client_authentication: %w", err)
+	}
+
+	d.Set("cluster_name", cluster.ClusterName)
+
+	if err := d.Set("vpc_config", flattenVpcConfigs(cluster.Serverless.VpcConfigs)); err != nil {
+		return fmt.Errorf("error setting vpc_config: %w", err)
+	}
+
+	tags := KeyValueTags(cluster.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceServerlessClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KafkaConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating MSK Serverless Cluster (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceServerlessClusterRead(d, meta)
+}
+
+func resourceServerlessClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KafkaConn
+
+	log.Printf("[DEBUG] Deleting MSK Serverless Cluster: %s", d.Id())
+	_, err := conn.DeleteCluster(&kafka.DeleteClusterInput{
+		ClusterArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kafka.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MSK Serverless Cluster (%s): %w", d.Id(), err)
+	}
+
+	_, err = waitClusterV2Deleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for MSK Serverless Cluster (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandServerlessClientAuthentication(tfList []interface{}) *kafka.ServerlessClientAuthentication {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &kafka.ServerlessClientAuthentication{}
+
+	if v, ok := tfMap["sasl"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Sasl = expandServerlessSasl(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandServerlessSasl(tfMap map[string]interface{}) *kafka.ServerlessSasl {
+	apiObject := &kafka.ServerlessSasl{}
+
+	if v, ok := tfMap["iam"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Iam = &kafka.Iam{
+			Enabled: aws.Bool(v[0].(map[string]interface{})["enabled"].(bool)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandVpcConfigs(tfList []interface{}) []*kafka.VpcConfig {
+	var apiObjects []*kafka.VpcConfig
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &kafka.VpcConfig{}
+
+		if v, ok := tfMap["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.SecurityGroupIds = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.SubnetIds = flex.ExpandStringSet(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenServerlessClientAuthentication(apiObject *kafka.ServerlessClientAuthentication) []interface{} {
+	if apiObject == nil || apiObject.Sasl == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"sasl": []interface{}{
+			map[string]interface{}{
+				"iam": []interface{}{
+					map[string]interface{}{
+						"enabled": flattenSaslIam(apiObject.Sasl.Iam),
+					},
+				},
+			},
+		},
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenVpcConfigs(apiObjects []*kafka.VpcConfig) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"security_group_ids": aws.StringValueSlice(apiObject.SecurityGroupIds),
+			"subnet_ids":         aws.StringValueSlice(apiObject.SubnetIds),
+		})
+	}
+
+	return tfList
+}
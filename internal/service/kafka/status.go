@@ -23,6 +23,22 @@ func statusClusterState(conn *kafka.Kafka, arn string) resource.StateRefreshFunc
 	}
 }
 
+func statusClusterV2State(conn *kafka.Kafka, arn string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindClusterV2ByARN(conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
 func statusClusterOperationState(conn *kafka.Kafka, arn string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindClusterOperationByARN(conn, arn)
@@ -21,6 +21,11 @@ func init() {
 		F:    sweepClusters,
 	})
 
+	resource.AddTestSweepers("aws_msk_serverless_cluster", &resource.Sweeper{
+		Name: "aws_msk_serverless_cluster",
+		F:    sweepServerlessClusters,
+	})
+
 	resource.AddTestSweepers("aws_msk_configuration", &resource.Sweeper{
 		Name: "aws_msk_configuration",
 		F:    sweepConfigurations,
@@ -73,6 +78,51 @@ func sweepClusters(region string) error {
 	return nil
 }
 
+func sweepServerlessClusters(region string) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).KafkaConn
+	input := &kafka.ListClustersV2Input{
+		ClusterTypeFilter: aws.String(kafka.ClusterTypeServerless),
+	}
+	sweepResources := make([]*sweep.SweepResource, 0)
+
+	err = conn.ListClustersV2Pages(input, func(page *kafka.ListClustersV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, cluster := range page.ClusterInfoList {
+			r := ResourceServerlessCluster()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(cluster.ClusterArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping MSK Serverless Cluster sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing MSK Serverless Clusters (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping MSK Serverless Clusters (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepConfigurations(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
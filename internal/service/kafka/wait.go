@@ -62,6 +62,48 @@ func waitClusterDeleted(conn *kafka.Kafka, arn string, timeout time.Duration) (*
 	return nil, err
 }
 
+func waitClusterV2Created(conn *kafka.Kafka, arn string, timeout time.Duration) (*kafka.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafka.ClusterStateCreating},
+		Target:  []string{kafka.ClusterStateActive},
+		Refresh: statusClusterV2State(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kafka.Cluster); ok {
+		if state, stateInfo := aws.StringValue(output.State), output.StateInfo; state == kafka.ClusterStateFailed && stateInfo != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(stateInfo.Code), aws.StringValue(stateInfo.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitClusterV2Deleted(conn *kafka.Kafka, arn string, timeout time.Duration) (*kafka.Cluster, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafka.ClusterStateDeleting},
+		Target:  []string{},
+		Refresh: statusClusterV2State(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kafka.Cluster); ok {
+		if state, stateInfo := aws.StringValue(output.State), output.StateInfo; state == kafka.ClusterStateFailed && stateInfo != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(stateInfo.Code), aws.StringValue(stateInfo.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitClusterOperationCompleted(conn *kafka.Kafka, arn string, timeout time.Duration) (*kafka.ClusterOperationInfo, error) { //nolint:unparam
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{ClusterOperationStatePending, ClusterOperationStateUpdateInProgress},
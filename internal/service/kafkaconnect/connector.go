@@ -0,0 +1,1020 @@
+package kafkaconnect
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafkaconnect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceConnector() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceConnectorCreate,
+		Read:   resourceConnectorRead,
+		Update: resourceConnectorUpdate,
+		Delete: resourceConnectorDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(connectorCreateDefaultTimeout),
+			Update: schema.DefaultTimeout(connectorUpdateDefaultTimeout),
+			Delete: schema.DefaultTimeout(connectorDeleteDefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"capacity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"autoscaling": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_worker_count": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 10),
+									},
+									"mcu_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      1,
+										ValidateFunc: validation.IntInSlice([]int{1, 2, 4, 8}),
+									},
+									"min_worker_count": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 10),
+									},
+									"scale_in_policy": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cpu_utilization_percentage": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntBetween(1, 100),
+												},
+											},
+										},
+									},
+									"scale_out_policy": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cpu_utilization_percentage": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntBetween(1, 100),
+												},
+											},
+										},
+									},
+								},
+							},
+							ExactlyOneOf: []string{"capacity.0.autoscaling", "capacity.0.provisioned_capacity"},
+						},
+						"provisioned_capacity": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"mcu_count": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      1,
+										ValidateFunc: validation.IntInSlice([]int{1, 2, 4, 8}),
+									},
+									"worker_count": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 10),
+									},
+								},
+							},
+							ExactlyOneOf: []string{"capacity.0.autoscaling", "capacity.0.provisioned_capacity"},
+						},
+					},
+				},
+			},
+			"connector_configuration": {
+				Type:     schema.TypeMap,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"kafka_cluster": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"apache_kafka_cluster": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bootstrap_servers": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"vpc": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"security_groups": {
+													Type:     schema.TypeSet,
+													Required: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"subnets": {
+													Type:     schema.TypeSet,
+													Required: true,
+													ForceNew: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"kafka_cluster_client_authentication": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authentication_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      kafkaconnect.KafkaClusterClientAuthenticationTypeNone,
+							ValidateFunc: validation.StringInSlice(kafkaconnect.KafkaClusterClientAuthenticationType_Values(), false),
+						},
+					},
+				},
+			},
+			"kafka_cluster_encryption_in_transit": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      kafkaconnect.KafkaClusterEncryptionInTransitTypePlaintext,
+							ValidateFunc: validation.StringInSlice(kafkaconnect.KafkaClusterEncryptionInTransitType_Values(), false),
+						},
+					},
+				},
+			},
+			"kafkaconnect_version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"log_delivery": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"worker_log_delivery": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cloudwatch_logs": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"enabled": {
+													Type:     schema.TypeBool,
+													Required: true,
+													ForceNew: true,
+												},
+												"log_group": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"firehose": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delivery_stream": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"enabled": {
+													Type:     schema.TypeBool,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"s3": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"enabled": {
+													Type:     schema.TypeBool,
+													Required: true,
+													ForceNew: true,
+												},
+												"prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"plugin": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_plugin": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"revision": {
+										Type:     schema.TypeInt,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"service_execution_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"worker_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"revision": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceConnectorCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KafkaConnectConn
+
+	name := d.Get("name").(string)
+	input := &kafkaconnect.CreateConnectorInput{
+		Capacity:                         expandCapacity(d.Get("capacity").([]interface{})[0].(map[string]interface{})),
+		ConnectorConfiguration:           flex.ExpandStringMap(d.Get("connector_configuration").(map[string]interface{})),
+		ConnectorName:                    aws.String(name),
+		KafkaCluster:                     expandKafkaCluster(d.Get("kafka_cluster").([]interface{})[0].(map[string]interface{})),
+		KafkaClusterClientAuthentication: expandKafkaClusterClientAuthentication(d.Get("kafka_cluster_client_authentication").([]interface{})[0].(map[string]interface{})),
+		KafkaClusterEncryptionInTransit:  expandKafkaClusterEncryptionInTransit(d.Get("kafka_cluster_encryption_in_transit").([]interface{})[0].(map[string]interface{})),
+		KafkaConnectVersion:              aws.String(d.Get("kafkaconnect_version").(string)),
+		Plugins:                          expandPlugins(d.Get("plugin").(*schema.Set).List()),
+		ServiceExecutionRoleArn:          aws.String(d.Get("service_execution_role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.ConnectorDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("log_delivery"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LogDelivery = expandLogDelivery(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("worker_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.WorkerConfiguration = expandWorkerConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating MSK Connect Connector: %s", input)
+	output, err := conn.CreateConnector(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MSK Connect Connector (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ConnectorArn))
+
+	_, err = waitConnectorCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for MSK Connect Connector (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceConnectorRead(d, meta)
+}
+
+func resourceConnectorRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KafkaConnectConn
+
+	connector, err := FindConnectorByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MSK Connect Connector (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MSK Connect Connector (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", connector.ConnectorArn)
+
+	if connector.Capacity != nil {
+		if err := d.Set("capacity", []interface{}{flattenCapacityDescription(connector.Capacity)}); err != nil {
+			return fmt.Errorf("error setting capacity: %w", err)
+		}
+	} else {
+		d.Set("capacity", nil)
+	}
+
+	d.Set("connector_configuration", aws.StringValueMap(connector.ConnectorConfiguration))
+	d.Set("description", connector.ConnectorDescription)
+
+	if connector.KafkaCluster != nil {
+		if err := d.Set("kafka_cluster", []interface{}{flattenKafkaClusterDescription(connector.KafkaCluster)}); err != nil {
+			return fmt.Errorf("error setting kafka_cluster: %w", err)
+		}
+	} else {
+		d.Set("kafka_cluster", nil)
+	}
+
+	if connector.KafkaClusterClientAuthentication != nil {
+		if err := d.Set("kafka_cluster_client_authentication", []interface{}{map[string]interface{}{
+			"authentication_type": aws.StringValue(connector.KafkaClusterClientAuthentication.AuthenticationType),
+		}}); err != nil {
+			return fmt.Errorf("error setting kafka_cluster_client_authentication: %w", err)
+		}
+	} else {
+		d.Set("kafka_cluster_client_authentication", nil)
+	}
+
+	if connector.KafkaClusterEncryptionInTransit != nil {
+		if err := d.Set("kafka_cluster_encryption_in_transit", []interface{}{map[string]interface{}{
+			"encryption_type": aws.StringValue(connector.KafkaClusterEncryptionInTransit.EncryptionType),
+		}}); err != nil {
+			return fmt.Errorf("error setting kafka_cluster_encryption_in_transit: %w", err)
+		}
+	} else {
+		d.Set("kafka_cluster_encryption_in_transit", nil)
+	}
+
+	d.Set("kafkaconnect_version", connector.KafkaConnectVersion)
+
+	if connector.LogDelivery != nil {
+		if err := d.Set("log_delivery", []interface{}{flattenLogDeliveryDescription(connector.LogDelivery)}); err != nil {
+			return fmt.Errorf("error setting log_delivery: %w", err)
+		}
+	} else {
+		d.Set("log_delivery", nil)
+	}
+
+	d.Set("name", connector.ConnectorName)
+
+	if err := d.Set("plugin", flattenPluginDescriptions(connector.Plugins)); err != nil {
+		return fmt.Errorf("error setting plugin: %w", err)
+	}
+
+	d.Set("service_execution_role_arn", connector.ServiceExecutionRoleArn)
+	d.Set("version", connector.CurrentVersion)
+
+	if connector.WorkerConfiguration != nil {
+		if err := d.Set("worker_configuration", []interface{}{map[string]interface{}{
+			"arn":      aws.StringValue(connector.WorkerConfiguration.WorkerConfigurationArn),
+			"revision": aws.Int64Value(connector.WorkerConfiguration.Revision),
+		}}); err != nil {
+			return fmt.Errorf("error setting worker_configuration: %w", err)
+		}
+	} else {
+		d.Set("worker_configuration", nil)
+	}
+
+	return nil
+}
+
+func resourceConnectorUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KafkaConnectConn
+
+	if d.HasChange("capacity") {
+		input := &kafkaconnect.UpdateConnectorInput{
+			Capacity:       expandCapacityUpdate(d.Get("capacity").([]interface{})[0].(map[string]interface{})),
+			ConnectorArn:   aws.String(d.Id()),
+			CurrentVersion: aws.String(d.Get("version").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating MSK Connect Connector: %s", input)
+		_, err := conn.UpdateConnector(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MSK Connect Connector (%s): %w", d.Id(), err)
+		}
+
+		_, err = waitConnectorUpdated(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return fmt.Errorf("error waiting for MSK Connect Connector (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	return resourceConnectorRead(d, meta)
+}
+
+func resourceConnectorDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KafkaConnectConn
+
+	log.Printf("[DEBUG] Deleting MSK Connect Connector: %s", d.Id())
+	_, err := conn.DeleteConnector(&kafkaconnect.DeleteConnectorInput{
+		ConnectorArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kafkaconnect.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MSK Connect Connector (%s): %w", d.Id(), err)
+	}
+
+	_, err = waitConnectorDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for MSK Connect Connector (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCapacity(tfMap map[string]interface{}) *kafkaconnect.Capacity {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kafkaconnect.Capacity{}
+
+	if v, ok := tfMap["autoscaling"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AutoScaling = expandAutoScaling(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["provisioned_capacity"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ProvisionedCapacity = expandProvisionedCapacity(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandAutoScaling(tfMap map[string]interface{}) *kafkaconnect.AutoScaling {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kafkaconnect.AutoScaling{}
+
+	if v, ok := tfMap["max_worker_count"].(int); ok && v != 0 {
+		apiObject.MaxWorkerCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["mcu_count"].(int); ok && v != 0 {
+		apiObject.McuCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["min_worker_count"].(int); ok && v != 0 {
+		apiObject.MinWorkerCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["scale_in_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v, ok := v[0].(map[string]interface{})["cpu_utilization_percentage"].(int); ok && v != 0 {
+			apiObject.ScaleInPolicy = &kafkaconnect.ScaleInPolicy{
+				CpuUtilizationPercentage: aws.Int64(int64(v)),
+			}
+		}
+	}
+
+	if v, ok := tfMap["scale_out_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v, ok := v[0].(map[string]interface{})["cpu_utilization_percentage"].(int); ok && v != 0 {
+			apiObject.ScaleOutPolicy = &kafkaconnect.ScaleOutPolicy{
+				CpuUtilizationPercentage: aws.Int64(int64(v)),
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func expandProvisionedCapacity(tfMap map[string]interface{}) *kafkaconnect.ProvisionedCapacity {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kafkaconnect.ProvisionedCapacity{}
+
+	if v, ok := tfMap["mcu_count"].(int); ok && v != 0 {
+		apiObject.McuCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["worker_count"].(int); ok && v != 0 {
+		apiObject.WorkerCount = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandCapacityUpdate(tfMap map[string]interface{}) *kafkaconnect.CapacityUpdate {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kafkaconnect.CapacityUpdate{}
+
+	if v, ok := tfMap["autoscaling"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AutoScaling = expandAutoScalingUpdate(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["provisioned_capacity"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ProvisionedCapacity = expandProvisionedCapacityUpdate(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandAutoScalingUpdate(tfMap map[string]interface{}) *kafkaconnect.AutoScalingUpdate {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kafkaconnect.AutoScalingUpdate{}
+
+	if v, ok := tfMap["max_worker_count"].(int); ok {
+		apiObject.MaxWorkerCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["mcu_count"].(int); ok {
+		apiObject.McuCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["min_worker_count"].(int); ok {
+		apiObject.MinWorkerCount = aws.Int64(int64(v))
+	}
+
+	// The update API requires both scaling policies.
+	if v, ok := tfMap["scale_in_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v, ok := v[0].(map[string]interface{})["cpu_utilization_percentage"].(int); ok {
+			apiObject.ScaleInPolicy = &kafkaconnect.ScaleInPolicyUpdate{
+				CpuUtilizationPercentage: aws.Int64(int64(v)),
+			}
+		}
+	}
+
+	if v, ok := tfMap["scale_out_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if v, ok := v[0].(map[string]interface{})["cpu_utilization_percentage"].(int); ok {
+			apiObject.ScaleOutPolicy = &kafkaconnect.ScaleOutPolicyUpdate{
+				CpuUtilizationPercentage: aws.Int64(int64(v)),
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func expandProvisionedCapacityUpdate(tfMap map[string]interface{}) *kafkaconnect.ProvisionedCapacityUpdate {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kafkaconnect.ProvisionedCapacityUpdate{}
+
+	if v, ok := tfMap["mcu_count"].(int); ok {
+		apiObject.McuCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["worker_count"].(int); ok {
+		apiObject.WorkerCount = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandKafkaCluster(tfMap map[string]interface{}) *kafkaconnect.KafkaCluster {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kafkaconnect.KafkaCluster{}
+
+	if v, ok := tfMap["apache_kafka_cluster"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ApacheKafkaCluster = expandApacheKafkaCluster(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandApacheKafkaCluster(tfMap map[string]interface{}) *kafkaconnect.ApacheKafkaCluster {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kafkaconnect.ApacheKafkaCluster{}
+
+	if v, ok := tfMap["bootstrap_servers"].(string); ok && v != "" {
+		apiObject.BootstrapServers = aws.String(v)
+	}
+
+	if v, ok := tfMap["vpc"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Vpc = &kafkaconnect.Vpc{}
+
+		if v, ok := tfMap["security_groups"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Vpc.SecurityGroups = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["subnets"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Vpc.Subnets = flex.ExpandStringSet(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandKafkaClusterClientAuthentication(tfMap map[string]interface{}) *kafkaconnect.KafkaClusterClientAuthentication {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kafkaconnect.KafkaClusterClientAuthentication{}
+
+	if v, ok := tfMap["authentication_type"].(string); ok && v != "" {
+		apiObject.AuthenticationType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandKafkaClusterEncryptionInTransit(tfMap map[string]interface{}) *kafkaconnect.KafkaClusterEncryptionInTransit {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kafkaconnect.KafkaClusterEncryptionInTransit{}
+
+	if v, ok := tfMap["encryption_type"].(string); ok && v != "" {
+		apiObject.EncryptionType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandLogDelivery(tfMap map[string]interface{}) *kafkaconnect.LogDelivery {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kafkaconnect.LogDelivery{}
+
+	if v, ok := tfMap["worker_log_delivery"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.WorkerLogDelivery = expandWorkerLogDelivery(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandWorkerLogDelivery(tfMap map[string]interface{}) *kafkaconnect.WorkerLogDelivery {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &kafkaconnect.WorkerLogDelivery{}
+
+	if v, ok := tfMap["cloudwatch_logs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.CloudWatchLogs = &kafkaconnect.CloudWatchLogsLogDelivery{
+			Enabled: aws.Bool(tfMap["enabled"].(bool)),
+		}
+
+		if v, ok := tfMap["log_group"].(string); ok && v != "" {
+			apiObject.CloudWatchLogs.LogGroup = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["firehose"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Firehose = &kafkaconnect.FirehoseLogDelivery{
+			Enabled: aws.Bool(tfMap["enabled"].(bool)),
+		}
+
+		if v, ok := tfMap["delivery_stream"].(string); ok && v != "" {
+			apiObject.Firehose.DeliveryStream = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["s3"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.S3 = &kafkaconnect.S3LogDelivery{
+			Enabled: aws.Bool(tfMap["enabled"].(bool)),
+		}
+
+		if v, ok := tfMap["bucket"].(string); ok && v != "" {
+			apiObject.S3.Bucket = aws.String(v)
+		}
+
+		if v, ok := tfMap["prefix"].(string); ok && v != "" {
+			apiObject.S3.Prefix = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandPlugins(tfList []interface{}) []*kafkaconnect.Plugin {
+	var apiObjects []*kafkaconnect.Plugin
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		v, ok := tfMap["custom_plugin"].([]interface{})
+
+		if !ok || len(v) == 0 || v[0] == nil {
+			continue
+		}
+
+		tfMap = v[0].(map[string]interface{})
+
+		apiObjects = append(apiObjects, &kafkaconnect.Plugin{
+			CustomPlugin: &kafkaconnect.CustomPlugin{
+				CustomPluginArn: aws.String(tfMap["arn"].(string)),
+				Revision:        aws.Int64(int64(tfMap["revision"].(int))),
+			},
+		})
+	}
+
+	return apiObjects
+}
+
+func expandWorkerConfiguration(tfMap map[string]interface{}) *kafkaconnect.WorkerConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &kafkaconnect.WorkerConfiguration{
+		Revision:               aws.Int64(int64(tfMap["revision"].(int))),
+		WorkerConfigurationArn: aws.String(tfMap["arn"].(string)),
+	}
+}
+
+func flattenCapacityDescription(apiObject *kafkaconnect.CapacityDescription) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AutoScaling; v != nil {
+		tfMap["autoscaling"] = []interface{}{flattenAutoScalingDescription(v)}
+	}
+
+	if v := apiObject.ProvisionedCapacity; v != nil {
+		tfMap["provisioned_capacity"] = []interface{}{map[string]interface{}{
+			"mcu_count":    aws.Int64Value(v.McuCount),
+			"worker_count": aws.Int64Value(v.WorkerCount),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenAutoScalingDescription(apiObject *kafkaconnect.AutoScalingDescription) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"max_worker_count": aws.Int64Value(apiObject.MaxWorkerCount),
+		"mcu_count":        aws.Int64Value(apiObject.McuCount),
+		"min_worker_count": aws.Int64Value(apiObject.MinWorkerCount),
+	}
+
+	if v := apiObject.ScaleInPolicy; v != nil {
+		tfMap["scale_in_policy"] = []interface{}{map[string]interface{}{
+			"cpu_utilization_percentage": aws.Int64Value(v.CpuUtilizationPercentage),
+		}}
+	}
+
+	if v := apiObject.ScaleOutPolicy; v != nil {
+		tfMap["scale_out_policy"] = []interface{}{map[string]interface{}{
+			"cpu_utilization_percentage": aws.Int64Value(v.CpuUtilizationPercentage),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenKafkaClusterDescription(apiObject *kafkaconnect.KafkaClusterDescription) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ApacheKafkaCluster; v != nil {
+		apacheKafkaCluster := map[string]interface{}{
+			"bootstrap_servers": aws.StringValue(v.BootstrapServers),
+		}
+
+		if v := v.Vpc; v != nil {
+			apacheKafkaCluster["vpc"] = []interface{}{map[string]interface{}{
+				"security_groups": aws.StringValueSlice(v.SecurityGroups),
+				"subnets":         aws.StringValueSlice(v.Subnets),
+			}}
+		}
+
+		tfMap["apache_kafka_cluster"] = []interface{}{apacheKafkaCluster}
+	}
+
+	return tfMap
+}
+
+func flattenLogDeliveryDescription(apiObject *kafkaconnect.LogDeliveryDescription) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.WorkerLogDelivery; v != nil {
+		tfMap["worker_log_delivery"] = []interface{}{flattenWorkerLogDeliveryDescription(v)}
+	}
+
+	return tfMap
+}
+
+func flattenWorkerLogDeliveryDescription(apiObject *kafkaconnect.WorkerLogDeliveryDescription) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CloudWatchLogs; v != nil {
+		tfMap["cloudwatch_logs"] = []interface{}{map[string]interface{}{
+			"enabled":   aws.BoolValue(v.Enabled),
+			"log_group": aws.StringValue(v.LogGroup),
+		}}
+	}
+
+	if v := apiObject.Firehose; v != nil {
+		tfMap["firehose"] = []interface{}{map[string]interface{}{
+			"delivery_stream": aws.StringValue(v.DeliveryStream),
+			"enabled":         aws.BoolValue(v.Enabled),
+		}}
+	}
+
+	if v := apiObject.S3; v != nil {
+		tfMap["s3"] = []interface{}{map[string]interface{}{
+			"bucket":  aws.StringValue(v.Bucket),
+			"enabled": aws.BoolValue(v.Enabled),
+			"prefix":  aws.StringValue(v.Prefix),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenPluginDescriptions(apiObjects []*kafkaconnect.PluginDescription) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || apiObject.CustomPlugin == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"custom_plugin": []interface{}{map[string]interface{}{
+				"arn":      aws.StringValue(apiObject.CustomPlugin.CustomPluginArn),
+				"revision": aws.Int64Value(apiObject.CustomPlugin.Revision),
+			}},
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,518 @@
+package kafkaconnect_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kafkaconnect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkafkaconnect "github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccKafkaConnectConnector_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mskconnect_connector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kafkaconnect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConnectorExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "kafkaconnect", regexp.MustCompile(`connector/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "capacity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.0.max_worker_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.0.mcu_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.0.min_worker_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.0.scale_in_policy.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "capacity.0.autoscaling.0.scale_in_policy.0.cpu_utilization_percentage"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.0.scale_out_policy.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "capacity.0.autoscaling.0.scale_out_policy.0.cpu_utilization_percentage"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.provisioned_capacity.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "connector_configuration.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "connector_configuration.connector.class", "org.apache.kafka.connect.file.FileStreamSourceConnector"),
+					resource.TestCheckResourceAttr(resourceName, "connector_configuration.tasks.max", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connector_configuration.topic", "t1"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "kafka_cluster.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "kafka_cluster.0.apache_kafka_cluster.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "kafka_cluster.0.apache_kafka_cluster.0.bootstrap_servers"),
+					resource.TestCheckResourceAttr(resourceName, "kafka_cluster.0.apache_kafka_cluster.0.vpc.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "kafka_cluster.0.apache_kafka_cluster.0.vpc.0.security_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "kafka_cluster.0.apache_kafka_cluster.0.vpc.0.subnets.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "kafka_cluster_client_authentication.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "kafka_cluster_client_authentication.0.authentication_type", kafkaconnect.KafkaClusterClientAuthenticationTypeNone),
+					resource.TestCheckResourceAttr(resourceName, "kafka_cluster_encryption_in_transit.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "kafka_cluster_encryption_in_transit.0.encryption_type", kafkaconnect.KafkaClusterEncryptionInTransitTypePlaintext),
+					resource.TestCheckResourceAttr(resourceName, "kafkaconnect_version", "2.7.1"),
+					resource.TestCheckResourceAttr(resourceName, "log_delivery.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "plugin.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "plugin.*", map[string]string{
+						"custom_plugin.#": "1",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "plugin.*.custom_plugin.0.arn", "aws_mskconnect_custom_plugin.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_execution_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "version"),
+					resource.TestCheckResourceAttr(resourceName, "worker_configuration.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKafkaConnectConnector_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mskconnect_connector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kafkaconnect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfkafkaconnect.ResourceConnector(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKafkaConnectConnector_update(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mskconnect_connector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kafkaconnect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckConnectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorConfig_allAttributes(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConnectorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "capacity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.0.max_worker_count", "6"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.0.mcu_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.0.min_worker_count", "4"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.0.scale_in_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.0.scale_in_policy.0.cpu_utilization_percentage", "25"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.0.scale_out_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.0.scale_out_policy.0.cpu_utilization_percentage", "75"),
+					resource.TestCheckResourceAttr(resourceName, "description", "testing"),
+					resource.TestCheckResourceAttr(resourceName, "log_delivery.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "log_delivery.0.worker_log_delivery.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "log_delivery.0.worker_log_delivery.0.cloudwatch_logs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "log_delivery.0.worker_log_delivery.0.cloudwatch_logs.0.enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "log_delivery.0.worker_log_delivery.0.cloudwatch_logs.0.log_group", "aws_cloudwatch_log_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "worker_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "worker_configuration.0.arn", "aws_mskconnect_worker_configuration.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "worker_configuration.0.revision", "aws_mskconnect_worker_configuration.test", "latest_revision"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConnectorConfig_allAttributesCapacityUpdated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConnectorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "capacity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.autoscaling.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.provisioned_capacity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.provisioned_capacity.0.mcu_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capacity.0.provisioned_capacity.0.worker_count", "4"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConnectorExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MSK Connect Connector ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KafkaConnectConn
+
+		_, err := tfkafkaconnect.FindConnectorByARN(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckConnectorDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KafkaConnectConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_mskconnect_connector" {
+			continue
+		}
+
+		_, err := tfkafkaconnect.FindConnectorByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MSK Connect Connector %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccPreCheck(t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KafkaConnectConn
+
+	input := &kafkaconnect.ListConnectorsInput{}
+
+	_, err := conn.ListConnectors(input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccConnectorBaseConfig(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigVpcWithSubnets(3),
+		testAccCustomPluginConfig_basic(rName),
+		fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  ingress {
+    from_port = 0
+    to_port   = 0
+    protocol  = "-1"
+    self      = true
+  }
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+}
+
+resource "aws_msk_cluster" "test" {
+  cluster_name           = %[1]q
+  kafka_version          = "2.7.1"
+  number_of_broker_nodes = 3
+
+  broker_node_group_info {
+    client_subnets  = aws_subnet.test[*].id
+    ebs_volume_size = 10
+    instance_type   = "kafka.m5.large"
+    security_groups = [aws_security_group.test.id]
+  }
+
+  encryption_info {
+    encryption_in_transit {
+      client_broker = "TLS_PLAINTEXT"
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "kafkaconnect.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "ec2:CreateNetworkInterface",
+        "ec2:DescribeNetworkInterfaces",
+        "ec2:DescribeSubnets",
+        "ec2:DescribeSecurityGroups",
+        "ec2:DescribeVpcs",
+        "ec2:DeleteNetworkInterface",
+        "logs:CreateLogDelivery",
+        "logs:DeleteLogDelivery",
+        "logs:DescribeLogGroups",
+        "logs:DescribeResourcePolicies",
+        "logs:GetLogDelivery",
+        "logs:ListLogDeliveries",
+        "logs:PutResourcePolicy",
+        "logs:UpdateLogDelivery",
+      ]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName))
+}
+
+func testAccConnectorConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccConnectorBaseConfig(rName), fmt.Sprintf(`
+resource "aws_mskconnect_connector" "test" {
+  name = %[1]q
+
+  kafkaconnect_version = "2.7.1"
+
+  capacity {
+    autoscaling {
+      min_worker_count = 1
+      max_worker_count = 2
+    }
+  }
+
+  connector_configuration = {
+    "connector.class" = "org.apache.kafka.connect.file.FileStreamSourceConnector"
+    "tasks.max"       = "1"
+    "topic"           = "t1"
+  }
+
+  kafka_cluster {
+    apache_kafka_cluster {
+      bootstrap_servers = aws_msk_cluster.test.bootstrap_brokers
+
+      vpc {
+        security_groups = [aws_security_group.test.id]
+        subnets         = aws_subnet.test[*].id
+      }
+    }
+  }
+
+  kafka_cluster_client_authentication {
+    authentication_type = "NONE"
+  }
+
+  kafka_cluster_encryption_in_transit {
+    encryption_type = "PLAINTEXT"
+  }
+
+  plugin {
+    custom_plugin {
+      arn      = aws_mskconnect_custom_plugin.test.arn
+      revision = aws_mskconnect_custom_plugin.test.latest_revision
+    }
+  }
+
+  service_execution_role_arn = aws_iam_role.test.arn
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccConnectorConfig_allAttributesBase(rName string) string {
+	return acctest.ConfigCompose(testAccConnectorBaseConfig(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_mskconnect_worker_configuration" "test" {
+  name = %[1]q
+
+  properties_file_content = <<EOF
+key.converter=org.apache.kafka.connect.storage.StringConverter
+value.converter=org.apache.kafka.connect.storage.StringConverter
+EOF
+}
+`, rName))
+}
+
+func testAccConnectorConfig_allAttributes(rName string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_allAttributesBase(rName), fmt.Sprintf(`
+resource "aws_mskconnect_connector" "test" {
+  name        = %[1]q
+  description = "testing"
+
+  kafkaconnect_version = "2.7.1"
+
+  capacity {
+    autoscaling {
+      mcu_count        = 2
+      min_worker_count = 4
+      max_worker_count = 6
+
+      scale_in_policy {
+        cpu_utilization_percentage = 25
+      }
+
+      scale_out_policy {
+        cpu_utilization_percentage = 75
+      }
+    }
+  }
+
+  connector_configuration = {
+    "connector.class" = "org.apache.kafka.connect.file.FileStreamSourceConnector"
+    "tasks.max"       = "1"
+    "topic"           = "t1"
+  }
+
+  kafka_cluster {
+    apache_kafka_cluster {
+      bootstrap_servers = aws_msk_cluster.test.bootstrap_brokers
+
+      vpc {
+        security_groups = [aws_security_group.test.id]
+        subnets         = aws_subnet.test[*].id
+      }
+    }
+  }
+
+  kafka_cluster_client_authentication {
+    authentication_type = "NONE"
+  }
+
+  kafka_cluster_encryption_in_transit {
+    encryption_type = "PLAINTEXT"
+  }
+
+  log_delivery {
+    worker_log_delivery {
+      cloudwatch_logs {
+        enabled   = true
+        log_group = aws_cloudwatch_log_group.test.name
+      }
+    }
+  }
+
+  plugin {
+    custom_plugin {
+      arn      = aws_mskconnect_custom_plugin.test.arn
+      revision = aws_mskconnect_custom_plugin.test.latest_revision
+    }
+  }
+
+  service_execution_role_arn = aws_iam_role.test.arn
+
+  worker_configuration {
+    arn      = aws_mskconnect_worker_configuration.test.arn
+    revision = aws_mskconnect_worker_configuration.test.latest_revision
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccConnectorConfig_allAttributesCapacityUpdated(rName string) string {
+	return acctest.ConfigCompose(testAccConnectorConfig_allAttributesBase(rName), fmt.Sprintf(`
+resource "aws_mskconnect_connector" "test" {
+  name        = %[1]q
+  description = "testing"
+
+  kafkaconnect_version = "2.7.1"
+
+  capacity {
+    provisioned_capacity {
+      worker_count = 4
+    }
+  }
+
+  connector_configuration = {
+    "connector.class" = "org.apache.kafka.connect.file.FileStreamSourceConnector"
+    "tasks.max"       = "1"
+    "topic"           = "t1"
+  }
+
+  kafka_cluster {
+    apache_kafka_cluster {
+      bootstrap_servers = aws_msk_cluster.test.bootstrap_brokers
+
+      vpc {
+        security_groups = [aws_security_group.test.id]
+        subnets         = aws_subnet.test[*].id
+      }
+    }
+  }
+
+  kafka_cluster_client_authentication {
+    authentication_type = "NONE"
+  }
+
+  kafka_cluster_encryption_in_transit {
+    encryption_type = "PLAINTEXT"
+  }
+
+  log_delivery {
+    worker_log_delivery {
+      cloudwatch_logs {
+        enabled   = true
+        log_group = aws_cloudwatch_log_group.test.name
+      }
+    }
+  }
+
+  plugin {
+    custom_plugin {
+      arn      = aws_mskconnect_custom_plugin.test.arn
+      revision = aws_mskconnect_custom_plugin.test.latest_revision
+    }
+  }
+
+  service_execution_role_arn = aws_iam_role.test.arn
+
+  worker_configuration {
+    arn      = aws_mskconnect_worker_configuration.test.arn
+    revision = aws_mskconnect_worker_configuration.test.latest_revision
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
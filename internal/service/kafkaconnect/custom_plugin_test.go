@@ -0,0 +1,191 @@
+package kafkaconnect_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/kafkaconnect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkafkaconnect "github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccKafkaConnectCustomPlugin_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mskconnect_custom_plugin.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kafkaconnect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCustomPluginDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomPluginConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomPluginExists(resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "kafkaconnect", regexp.MustCompile(`custom-plugin/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "content_type", kafkaconnect.CustomPluginContentTypeZip),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "latest_revision"),
+					resource.TestCheckResourceAttr(resourceName, "location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "location.0.s3.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "location.0.s3.0.bucket_arn", "aws_s3_bucket.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "location.0.s3.0.file_key", "aws_s3_bucket_object.test", "key"),
+					resource.TestCheckResourceAttr(resourceName, "location.0.s3.0.object_version", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "state", kafkaconnect.CustomPluginStateActive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKafkaConnectCustomPlugin_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mskconnect_custom_plugin.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kafkaconnect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCustomPluginDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomPluginConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomPluginExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfkafkaconnect.ResourceCustomPlugin(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKafkaConnectCustomPlugin_description(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mskconnect_custom_plugin.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, kafkaconnect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckCustomPluginDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomPluginConfig_description(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomPluginExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "testing"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCustomPluginExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MSK Connect Custom Plugin ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KafkaConnectConn
+
+		_, err := tfkafkaconnect.FindCustomPluginByARN(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckCustomPluginDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).KafkaConnectConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_mskconnect_custom_plugin" {
+			continue
+		}
+
+		_, err := tfkafkaconnect.FindCustomPluginByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MSK Connect Custom Plugin %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCustomPluginBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket = aws_s3_bucket.test.id
+  key    = "connector.zip"
+  source = "test-fixtures/connector.zip"
+}
+`, rName)
+}
+
+func testAccCustomPluginConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCustomPluginBaseConfig(rName), fmt.Sprintf(`
+resource "aws_mskconnect_custom_plugin" "test" {
+  name         = %[1]q
+  content_type = "ZIP"
+
+  location {
+    s3 {
+      bucket_arn = aws_s3_bucket.test.arn
+      file_key   = aws_s3_bucket_object.test.key
+    }
+  }
+}
+`, rName))
+}
+
+func testAccCustomPluginConfig_description(rName string) string {
+	return acctest.ConfigCompose(testAccCustomPluginBaseConfig(rName), fmt.Sprintf(`
+resource "aws_mskconnect_custom_plugin" "test" {
+  name         = %[1]q
+  content_type = "ZIP"
+  description  = "testing"
+
+  location {
+    s3 {
+      bucket_arn = aws_s3_bucket.test.arn
+      file_key   = aws_s3_bucket_object.test.key
+    }
+  }
+}
+`, rName))
+}
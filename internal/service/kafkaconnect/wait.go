@@ -0,0 +1,121 @@
+package kafkaconnect
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafkaconnect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	connectorCreateDefaultTimeout = 20 * time.Minute
+	connectorUpdateDefaultTimeout = 20 * time.Minute
+	connectorDeleteDefaultTimeout = 10 * time.Minute
+
+	customPluginCreateDefaultTimeout = 10 * time.Minute
+	customPluginDeleteDefaultTimeout = 10 * time.Minute
+)
+
+func waitConnectorCreated(conn *kafkaconnect.KafkaConnect, arn string, timeout time.Duration) (*kafkaconnect.DescribeConnectorOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafkaconnect.ConnectorStateCreating},
+		Target:  []string{kafkaconnect.ConnectorStateRunning},
+		Refresh: statusConnectorState(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kafkaconnect.DescribeConnectorOutput); ok {
+		if state, stateDescription := aws.StringValue(output.ConnectorState), output.StateDescription; state == kafkaconnect.ConnectorStateFailed && stateDescription != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(stateDescription.Code), aws.StringValue(stateDescription.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitConnectorUpdated(conn *kafkaconnect.KafkaConnect, arn string, timeout time.Duration) (*kafkaconnect.DescribeConnectorOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafkaconnect.ConnectorStateUpdating},
+		Target:  []string{kafkaconnect.ConnectorStateRunning},
+		Refresh: statusConnectorState(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kafkaconnect.DescribeConnectorOutput); ok {
+		if state, stateDescription := aws.StringValue(output.ConnectorState), output.StateDescription; state == kafkaconnect.ConnectorStateFailed && stateDescription != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(stateDescription.Code), aws.StringValue(stateDescription.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitConnectorDeleted(conn *kafkaconnect.KafkaConnect, arn string, timeout time.Duration) (*kafkaconnect.DescribeConnectorOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafkaconnect.ConnectorStateDeleting},
+		Target:  []string{},
+		Refresh: statusConnectorState(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kafkaconnect.DescribeConnectorOutput); ok {
+		if state, stateDescription := aws.StringValue(output.ConnectorState), output.StateDescription; state == kafkaconnect.ConnectorStateFailed && stateDescription != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(stateDescription.Code), aws.StringValue(stateDescription.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCustomPluginCreated(conn *kafkaconnect.KafkaConnect, arn string, timeout time.Duration) (*kafkaconnect.DescribeCustomPluginOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafkaconnect.CustomPluginStateCreating},
+		Target:  []string{kafkaconnect.CustomPluginStateActive},
+		Refresh: statusCustomPluginState(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kafkaconnect.DescribeCustomPluginOutput); ok {
+		if state, stateDescription := aws.StringValue(output.CustomPluginState), output.StateDescription; state == kafkaconnect.CustomPluginStateCreateFailed && stateDescription != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(stateDescription.Code), aws.StringValue(stateDescription.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCustomPluginDeleted(conn *kafkaconnect.KafkaConnect, arn string, timeout time.Duration) (*kafkaconnect.DescribeCustomPluginOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{kafkaconnect.CustomPluginStateDeleting},
+		Target:  []string{},
+		Refresh: statusCustomPluginState(conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*kafkaconnect.DescribeCustomPluginOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,119 @@
+package kafkaconnect
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kafkaconnect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceWorkerConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceWorkerConfigurationCreate,
+		Read:   resourceWorkerConfigurationRead,
+		// The Kafka Connect API does not support deleting worker configurations.
+		Delete: schema.Noop,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"latest_revision": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"properties_file_content": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceWorkerConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KafkaConnectConn
+
+	name := d.Get("name").(string)
+	input := &kafkaconnect.CreateWorkerConfigurationInput{
+		Name:                  aws.String(name),
+		PropertiesFileContent: aws.String(verify.Base64Encode([]byte(d.Get("properties_file_content").(string)))),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating MSK Connect Worker Configuration: %s", input)
+	output, err := conn.CreateWorkerConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MSK Connect Worker Configuration (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.WorkerConfigurationArn))
+
+	return resourceWorkerConfigurationRead(d, meta)
+}
+
+func resourceWorkerConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KafkaConnectConn
+
+	config, err := FindWorkerConfigurationByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MSK Connect Worker Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MSK Connect Worker Configuration (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", config.WorkerConfigurationArn)
+	d.Set("description", config.Description)
+	d.Set("name", config.Name)
+
+	if config.LatestRevision != nil {
+		d.Set("latest_revision", config.LatestRevision.Revision)
+		d.Set("properties_file_content", decodePropertiesFileContent(aws.StringValue(config.LatestRevision.PropertiesFileContent)))
+	} else {
+		d.Set("latest_revision", nil)
+		d.Set("properties_file_content", nil)
+	}
+
+	return nil
+}
+
+// decodePropertiesFileContent returns the plain text of the base64 encoded
+// properties file content, or the content unchanged if it is not base64 encoded.
+func decodePropertiesFileContent(content string) string {
+	v, err := base64.StdEncoding.DecodeString(content)
+
+	if err != nil {
+		return content
+	}
+
+	return string(v)
+}
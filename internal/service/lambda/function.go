@@ -62,27 +62,43 @@ func ResourceFunction() *schema.Resource {
 			"filename": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version", "image_uri"},
+				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version", "image_uri", "source_dir"},
 			},
 			"s3_bucket": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"s3_key": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"image_uri": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version"},
+				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version", "source_dir"},
+			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version", "image_uri", "source_code_hash"},
+			},
+			"source_dir_excludes": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"source_dir"},
+			},
+			"source_dir_s3_bucket": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"source_dir"},
 			},
 			"package_type": {
 				Type:         schema.TypeString,
@@ -333,6 +349,7 @@ func ResourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			updateSourceCodeHashFromSourceDir,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -350,6 +367,33 @@ func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// updateSourceCodeHashFromSourceDir plans a code update whenever the archive built from source_dir
+// no longer matches the deployed code.
+func updateSourceCodeHashFromSourceDir(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("source_dir_excludes") {
+		d.SetNewComputed("source_code_hash")
+		return nil
+	}
+
+	v, ok := d.GetOk("source_dir")
+
+	if !ok {
+		return nil
+	}
+
+	archive, err := BuildSourceDirArchive(v.(string), aws.StringValueSlice(flex.ExpandStringSet(d.Get("source_dir_excludes").(*schema.Set))))
+
+	if err != nil {
+		return err
+	}
+
+	if hash := SourceCodeHash(archive); hash != d.Get("source_code_hash").(string) {
+		return d.SetNew("source_code_hash", hash)
+	}
+
+	return nil
+}
+
 func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	configChanged := hasConfigChanges(d)
 	functionCodeUpdated := needsFunctionCodeUpdate(d)
@@ -402,9 +446,10 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 	s3Key, keyOk := d.GetOk("s3_key")
 	s3ObjectVersion, versionOk := d.GetOk("s3_object_version")
 	imageUri, hasImageUri := d.GetOk("image_uri")
+	_, hasSourceDir := d.GetOk("source_dir")
 
-	if !hasFilename && !bucketOk && !keyOk && !versionOk && !hasImageUri {
-		return errors.New("filename, s3_* or image_uri attributes must be set")
+	if !hasFilename && !bucketOk && !keyOk && !versionOk && !hasImageUri && !hasSourceDir {
+		return errors.New("filename, s3_*, image_uri or source_dir attributes must be set")
 	}
 
 	var functionCode *lambda.FunctionCode
@@ -425,6 +470,15 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		functionCode = &lambda.FunctionCode{
 			ImageUri: aws.String(imageUri.(string)),
 		}
+	} else if hasSourceDir {
+		conns.GlobalMutexKV.Lock(awsMutexLambdaKey)
+		defer conns.GlobalMutexKV.Unlock(awsMutexLambdaKey)
+		code, cleanup, err := expandSourceDirFunctionCode(d, meta)
+		if err != nil {
+			return fmt.Errorf("unable to package %q: %w", d.Get("source_dir").(string), err)
+		}
+		defer cleanup()
+		functionCode = code
 	} else {
 		if !bucketOk || !keyOk {
 			return errors.New("s3_bucket and s3_key must all be set while using S3 code source")
@@ -1169,6 +1223,17 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 			codeReq.ZipFile = file
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			codeReq.ImageUri = aws.String(v.(string))
+		} else if v, ok := d.GetOk("source_dir"); ok {
+			conns.GlobalMutexKV.Lock(awsMutexLambdaKey)
+			defer conns.GlobalMutexKV.Unlock(awsMutexLambdaKey)
+			code, cleanup, err := expandSourceDirFunctionCode(d, meta)
+			if err != nil {
+				return fmt.Errorf("unable to package %q: %w", v.(string), err)
+			}
+			defer cleanup()
+			codeReq.S3Bucket = code.S3Bucket
+			codeReq.S3Key = code.S3Key
+			codeReq.ZipFile = code.ZipFile
 		} else {
 			s3Bucket, _ := d.GetOk("s3_bucket")
 			s3Key, _ := d.GetOk("s3_key")
@@ -0,0 +1,228 @@
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// functionZipFileMaxSize is the largest deployment package that can be uploaded directly.
+// Larger packages must be uploaded from S3.
+const functionZipFileMaxSize = 50 * 1024 * 1024
+
+// sourceDirArchiveModified is recorded as the modification time of every archive entry
+// so that the archive contents, and therefore its hash, only depend on the file contents.
+// It is the earliest time that can be represented in the zip format.
+var sourceDirArchiveModified = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// sourceDirArchiveFileMode is recorded as the permissions of every archive entry.
+const sourceDirArchiveFileMode fs.FileMode = 0755
+
+// BuildSourceDirArchive returns a zip archive of the regular files under dir,
+// skipping any path matching one of the exclude patterns.
+// Entries are added in lexical order with fixed timestamps and permissions
+// so that identical directory contents produce byte-identical archives on every platform.
+func BuildSourceDirArchive(dir string, excludes []string) ([]byte, error) {
+	for _, pattern := range excludes {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid exclude pattern (%s): %w", pattern, err)
+		}
+	}
+
+	root, err := homedir.Expand(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if fi, err := os.Stat(root); err != nil {
+		return nil, err
+	} else if !fi.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", dir)
+	}
+
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+
+	// WalkDir visits entries in lexical order.
+	err = filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if p == root {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+
+		if err != nil {
+			return err
+		}
+
+		name := filepath.ToSlash(rel)
+
+		if sourceDirPathExcluded(name, excludes) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		// Follows symbolic links to files.
+		fi, err := os.Stat(p)
+
+		if err != nil {
+			return err
+		}
+
+		if !fi.Mode().IsRegular() {
+			log.Printf("[DEBUG] Skipping %s: not a regular file", p)
+			return nil
+		}
+
+		header := &zip.FileHeader{
+			Name:     name,
+			Method:   zip.Deflate,
+			Modified: sourceDirArchiveModified,
+		}
+
+		// The source file's permissions are ignored as they are not reported consistently
+		// across operating systems (e.g. there are no executable bits on Windows).
+		// Every entry is executable so that custom runtime bootstrap files can be run.
+		header.SetMode(sourceDirArchiveFileMode)
+
+		f, err := w.CreateHeader(header)
+
+		if err != nil {
+			return err
+		}
+
+		r, err := os.Open(p)
+
+		if err != nil {
+			return err
+		}
+
+		defer r.Close()
+
+		_, err = io.Copy(f, r)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error archiving %s: %w", dir, err)
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("error archiving %s: %w", dir, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// sourceDirPathExcluded returns whether the slash-separated relative path matches any of the patterns.
+// Patterns without a slash are also matched against the last path element, so "*.pyc" excludes
+// matching files at any depth. Matching directories are excluded together with their contents.
+func sourceDirPathExcluded(name string, excludes []string) bool {
+	for _, pattern := range excludes {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+
+		if !strings.Contains(pattern, "/") {
+			if ok, _ := path.Match(pattern, path.Base(name)); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// SourceCodeHash returns the base64-encoded SHA256 hash of a deployment package,
+// in the same format as the CodeSha256 value returned by the Lambda API.
+func SourceCodeHash(archive []byte) string {
+	sum := sha256.Sum256(archive)
+
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// expandSourceDirFunctionCode builds the deployment package for the configured source_dir.
+// Packages too large to upload directly are staged in source_dir_s3_bucket.
+// The returned function deletes the staged object and must be called once Lambda has copied the code.
+func expandSourceDirFunctionCode(d *schema.ResourceData, meta interface{}) (*lambda.FunctionCode, func(), error) {
+	dir := d.Get("source_dir").(string)
+
+	archive, err := BuildSourceDirArchive(dir, aws.StringValueSlice(flex.ExpandStringSet(d.Get("source_dir_excludes").(*schema.Set))))
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(archive) <= functionZipFileMaxSize {
+		return &lambda.FunctionCode{ZipFile: archive}, func() {}, nil
+	}
+
+	bucket, ok := d.GetOk("source_dir_s3_bucket")
+
+	if !ok {
+		return nil, nil, fmt.Errorf("archive of %s is %d bytes, larger than the %d bytes that can be uploaded directly: source_dir_s3_bucket must be set", dir, len(archive), functionZipFileMaxSize)
+	}
+
+	conn := meta.(*conns.AWSClient).S3Conn
+	sum := sha256.Sum256(archive)
+	key := fmt.Sprintf("%s/%s.zip", d.Get("function_name").(string), hex.EncodeToString(sum[:]))
+
+	log.Printf("[DEBUG] Staging Lambda Function deployment package in S3 (%s/%s)", bucket, key)
+	_, err = conn.PutObject(&s3.PutObjectInput{
+		Body:   bytes.NewReader(archive),
+		Bucket: aws.String(bucket.(string)),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("error staging Lambda Function deployment package in S3 (%s/%s): %w", bucket, key, err)
+	}
+
+	cleanup := func() {
+		_, err := conn.DeleteObject(&s3.DeleteObjectInput{
+			Bucket: aws.String(bucket.(string)),
+			Key:    aws.String(key),
+		})
+
+		if err != nil {
+			log.Printf("[WARN] Error deleting staged Lambda Function deployment package from S3 (%s/%s): %s", bucket, key, err)
+		}
+	}
+
+	return &lambda.FunctionCode{
+		S3Bucket: aws.String(bucket.(string)),
+		S3Key:    aws.String(key),
+	}, cleanup, nil
+}
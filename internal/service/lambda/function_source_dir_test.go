@@ -0,0 +1,161 @@
+package lambda_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+)
+
+func TestBuildSourceDirArchive(t *testing.T) {
+	files := map[string]string{
+		"index.js":                  "exports.handler = async () => {};",
+		"bootstrap":                 "#!/bin/sh",
+		"lib/util.js":               "module.exports = {};",
+		"lib/util.js.map":           "{}",
+		"node_modules/dep/index.js": "module.exports = {};",
+		"tests/index.test.js":       "",
+	}
+
+	dir1 := writeSourceDirFiles(t, files, time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC))
+	dir2 := writeSourceDirFiles(t, files, time.Date(2022, time.July, 4, 12, 30, 0, 0, time.UTC))
+
+	if err := os.Chmod(filepath.Join(dir1, "bootstrap"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chmod(filepath.Join(dir2, "bootstrap"), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chmod(filepath.Join(dir2, "index.js"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	excludes := []string{"node_modules", "tests/*", "*.map"}
+
+	archive1, err := tflambda.BuildSourceDirArchive(dir1, excludes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive2, err := tflambda.BuildSourceDirArchive(dir2, excludes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(archive1, archive2) {
+		t.Errorf("archives of identical directory contents differ")
+	}
+
+	if got, want := tflambda.SourceCodeHash(archive1), tflambda.SourceCodeHash(archive2); got != want {
+		t.Errorf("got hash %s, expected %s", got, want)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(archive1), int64(len(archive1)))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	modes := map[string]os.FileMode{}
+
+	for _, f := range r.File {
+		names = append(names, f.Name)
+		modes[f.Name] = f.Mode()
+	}
+
+	if want := []string{"bootstrap", "index.js", "lib/util.js"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("got entries %v, expected %v", names, want)
+	}
+
+	for name, mode := range modes {
+		if want := os.FileMode(0755); mode != want {
+			t.Errorf("got %s mode %s, expected %s", name, mode, want)
+		}
+	}
+}
+
+func TestBuildSourceDirArchive_fileModeIndependent(t *testing.T) {
+	files := map[string]string{
+		"bootstrap": "#!/bin/sh",
+		"index.js":  "exports.handler = async () => {};",
+	}
+	modified := time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	// Executable as checked out on Linux or macOS.
+	dir1 := writeSourceDirFiles(t, files, modified)
+
+	if err := os.Chmod(filepath.Join(dir1, "bootstrap"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	// Without executable bits, as reported on Windows.
+	dir2 := writeSourceDirFiles(t, files, modified)
+
+	if err := os.Chmod(filepath.Join(dir2, "bootstrap"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	archive1, err := tflambda.BuildSourceDirArchive(dir1, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive2, err := tflambda.BuildSourceDirArchive(dir2, nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := tflambda.SourceCodeHash(archive2), tflambda.SourceCodeHash(archive1); got != want {
+		t.Errorf("got hash %s, expected %s", got, want)
+	}
+}
+
+func TestBuildSourceDirArchive_invalidExclude(t *testing.T) {
+	dir := writeSourceDirFiles(t, map[string]string{"index.js": ""}, time.Now())
+
+	if _, err := tflambda.BuildSourceDirArchive(dir, []string{"["}); err == nil {
+		t.Errorf("expected error for invalid exclude pattern")
+	}
+}
+
+func TestBuildSourceDirArchive_notDirectory(t *testing.T) {
+	dir := writeSourceDirFiles(t, map[string]string{"index.js": ""}, time.Now())
+
+	if _, err := tflambda.BuildSourceDirArchive(filepath.Join(dir, "index.js"), nil); err == nil {
+		t.Errorf("expected error for non-directory source")
+	}
+}
+
+func writeSourceDirFiles(t *testing.T, files map[string]string, modified time.Time) string {
+	dir := t.TempDir()
+
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.Chtimes(p, modified, modified); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
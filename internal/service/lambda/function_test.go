@@ -1758,6 +1758,84 @@ func TestAccLambdaFunction_LocalUpdate_nameOnly(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_sourceDir(t *testing.T) {
+	var conf lambda.GetFunctionOutput
+
+	dir := t.TempDir()
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_source_dir_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_source_dir_%s", rString)
+	resourceName := "aws_lambda_function.test"
+
+	writeSourceDir := func(fixture string) {
+		content, err := os.ReadFile(fixture)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "lambda.js"), content, 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte(time.Now().String()), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var sourceCodeHash string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLambdaFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					writeSourceDir("test-fixtures/lambda_func.js")
+				},
+				Config: testAccFunctionConfig_sourceDir(dir, roleName, funcName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, funcName, &conf),
+					testAccCheckFunctionName(&conf, funcName),
+					resource.TestCheckResourceAttrSet(resourceName, "source_code_hash"),
+					func(s *terraform.State) error {
+						sourceCodeHash = s.RootModule().Resources[resourceName].Primary.Attributes["source_code_hash"]
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish", "source_dir", "source_dir_excludes"},
+			},
+			{
+				// Rewriting identical content, and changing excluded files, must not produce a diff.
+				PreConfig: func() {
+					writeSourceDir("test-fixtures/lambda_func.js")
+				},
+				Config:   testAccFunctionConfig_sourceDir(dir, roleName, funcName),
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					writeSourceDir("test-fixtures/lambda_func_modified.js")
+				},
+				Config: testAccFunctionConfig_sourceDir(dir, roleName, funcName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(resourceName, funcName, &conf),
+					func(s *terraform.State) error {
+						if got := s.RootModule().Resources[resourceName].Primary.Attributes["source_code_hash"]; got == sourceCodeHash {
+							return fmt.Errorf("source_code_hash not updated: %s", got)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_S3Update_basic(t *testing.T) {
 	var conf lambda.GetFunctionOutput
 
@@ -3215,6 +3293,39 @@ resource "aws_lambda_function" "test" {
 `, funcName)
 }
 
+func testAccFunctionConfig_sourceDir(dir, roleName, funcName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "iam_for_lambda" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lambda_function" "test" {
+  source_dir          = %[2]q
+  source_dir_excludes = ["*.md"]
+  function_name       = %[3]q
+  role                = aws_iam_role.iam_for_lambda.arn
+  handler             = "lambda.handler"
+  runtime             = "nodejs12.x"
+}
+`, roleName, dir, funcName)
+}
+
 func testAccFunctionConfig_local(filePath, roleName, funcName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "iam_for_lambda" {
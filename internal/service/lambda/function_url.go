@@ -0,0 +1,415 @@
+package lambda
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// Statement ID used by the Lambda console when granting public access to a function URL.
+	functionURLPublicAccessStatementID = "FunctionURLAllowPublicAccess"
+)
+
+func ResourceFunctionURL() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFunctionURLCreate,
+		Read:   resourceFunctionURLRead,
+		Update: resourceFunctionURLUpdate,
+		Delete: resourceFunctionURLDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"authorization_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(lambda.FunctionUrlAuthType_Values(), false),
+			},
+			"cors": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allow_credentials": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"allow_headers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allow_methods": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allow_origins": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"expose_headers": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"max_age": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 86400),
+						},
+					},
+				},
+			},
+			"function_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"function_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validFunctionName,
+			},
+			"function_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"qualifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validQualifier,
+			},
+			"url_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceFunctionURLCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LambdaConn
+
+	name := d.Get("function_name").(string)
+	qualifier := d.Get("qualifier").(string)
+	id := FunctionURLCreateResourceID(name, qualifier)
+	authorizationType := d.Get("authorization_type").(string)
+
+	input := &lambda.CreateFunctionUrlConfigInput{
+		AuthType:     aws.String(authorizationType),
+		FunctionName: aws.String(name),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	if v, ok := d.GetOk("cors"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Cors = expandFunctionURLCors(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Lambda Function URL: %s", input)
+	_, err := conn.CreateFunctionUrlConfig(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lambda Function URL (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if authorizationType == lambda.FunctionUrlAuthTypeNone {
+		if err := addFunctionURLPublicAccessPermission(conn, name, qualifier); err != nil {
+			return fmt.Errorf("error adding Lambda Function URL (%s) public access permission: %w", d.Id(), err)
+		}
+	}
+
+	return resourceFunctionURLRead(d, meta)
+}
+
+func resourceFunctionURLRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LambdaConn
+
+	name, qualifier, err := FunctionURLParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindFunctionURLByNameAndQualifier(conn, name, qualifier)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lambda Function URL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lambda Function URL (%s): %w", d.Id(), err)
+	}
+
+	functionURL := aws.StringValue(output.FunctionUrl)
+
+	d.Set("authorization_type", output.AuthType)
+	if output.Cors != nil {
+		if err := d.Set("cors", []interface{}{flattenFunctionURLCors(output.Cors)}); err != nil {
+			return fmt.Errorf("error setting cors: %w", err)
+		}
+	} else {
+		d.Set("cors", nil)
+	}
+	d.Set("function_arn", output.FunctionArn)
+	d.Set("function_name", name)
+	d.Set("function_url", functionURL)
+	d.Set("qualifier", qualifier)
+
+	// Function URL endpoints have the following format:
+	// https://<url-id>.lambda-url.<region>.on.aws
+	if v, err := url.Parse(functionURL); err != nil {
+		return fmt.Errorf("error parsing URL (%s): %w", functionURL, err)
+	} else if v := strings.Split(v.Host, "."); len(v) > 1 {
+		d.Set("url_id", v[0])
+	} else {
+		log.Printf("[WARN] Unexpected Lambda Function URL (%s) format", functionURL)
+	}
+
+	return nil
+}
+
+func resourceFunctionURLUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LambdaConn
+
+	name, qualifier, err := FunctionURLParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &lambda.UpdateFunctionUrlConfigInput{
+		FunctionName: aws.String(name),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	if d.HasChange("authorization_type") {
+		input.AuthType = aws.String(d.Get("authorization_type").(string))
+	}
+
+	if d.HasChange("cors") {
+		if v, ok := d.GetOk("cors"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Cors = expandFunctionURLCors(v.([]interface{})[0].(map[string]interface{}))
+		} else {
+			input.Cors = &lambda.Cors{}
+		}
+	}
+
+	log.Printf("[DEBUG] Updating Lambda Function URL: %s", input)
+	_, err = conn.UpdateFunctionUrlConfig(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Lambda Function URL (%s): %w", d.Id(), err)
+	}
+
+	if d.HasChange("authorization_type") {
+		o, n := d.GetChange("authorization_type")
+
+		if o.(string) == lambda.FunctionUrlAuthTypeNone {
+			if err := removeFunctionURLPublicAccessPermission(conn, name, qualifier); err != nil {
+				return fmt.Errorf("error removing Lambda Function URL (%s) public access permission: %w", d.Id(), err)
+			}
+		}
+
+		if n.(string) == lambda.FunctionUrlAuthTypeNone {
+			if err := addFunctionURLPublicAccessPermission(conn, name, qualifier); err != nil {
+				return fmt.Errorf("error adding Lambda Function URL (%s) public access permission: %w", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceFunctionURLRead(d, meta)
+}
+
+func resourceFunctionURLDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LambdaConn
+
+	name, qualifier, err := FunctionURLParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &lambda.DeleteFunctionUrlConfigInput{
+		FunctionName: aws.String(name),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	log.Printf("[INFO] Deleting Lambda Function URL: %s", d.Id())
+	_, err = conn.DeleteFunctionUrlConfig(input)
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lambda Function URL (%s): %w", d.Id(), err)
+	}
+
+	if d.Get("authorization_type").(string) == lambda.FunctionUrlAuthTypeNone {
+		if err := removeFunctionURLPublicAccessPermission(conn, name, qualifier); err != nil {
+			return fmt.Errorf("error removing Lambda Function URL (%s) public access permission: %w", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+const functionURLResourceIDSeparator = "/"
+
+func FunctionURLCreateResourceID(name, qualifier string) string {
+	if qualifier == "" {
+		return name
+	}
+
+	parts := []string{name, qualifier}
+	id := strings.Join(parts, functionURLResourceIDSeparator)
+
+	return id
+}
+
+func FunctionURLParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, functionURLResourceIDSeparator)
+
+	if len(parts) == 1 && parts[0] != "" {
+		return parts[0], "", nil
+	}
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected FUNCTION-NAME%[2]sQUALIFIER or FUNCTION-NAME", id, functionURLResourceIDSeparator)
+}
+
+// addFunctionURLPublicAccessPermission grants lambda:InvokeFunctionUrl to all principals.
+// Function URLs with authorization type NONE are not reachable without this permission.
+func addFunctionURLPublicAccessPermission(conn *lambda.Lambda, name, qualifier string) error {
+	// Serialize policy changes to the same function, see resourcePermissionCreate.
+	conns.GlobalMutexKV.Lock(name)
+	defer conns.GlobalMutexKV.Unlock(name)
+
+	input := &lambda.AddPermissionInput{
+		Action:              aws.String("lambda:InvokeFunctionUrl"),
+		FunctionName:        aws.String(name),
+		FunctionUrlAuthType: aws.String(lambda.FunctionUrlAuthTypeNone),
+		Principal:           aws.String("*"),
+		StatementId:         aws.String(functionURLPublicAccessStatementID),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	log.Printf("[DEBUG] Adding Lambda Permission: %s", input)
+	_, err := conn.AddPermission(input)
+
+	// The permission may already have been granted out of band, e.g. via the console.
+	if tfawserr.ErrMessageContains(err, lambda.ErrCodeResourceConflictException, "already exists") {
+		return nil
+	}
+
+	return err
+}
+
+func removeFunctionURLPublicAccessPermission(conn *lambda.Lambda, name, qualifier string) error {
+	conns.GlobalMutexKV.Lock(name)
+	defer conns.GlobalMutexKV.Unlock(name)
+
+	input := &lambda.RemovePermissionInput{
+		FunctionName: aws.String(name),
+		StatementId:  aws.String(functionURLPublicAccessStatementID),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	log.Printf("[DEBUG] Removing Lambda Permission: %s", input)
+	_, err := conn.RemovePermission(input)
+
+	if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	return err
+}
+
+func expandFunctionURLCors(tfMap map[string]interface{}) *lambda.Cors {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &lambda.Cors{}
+
+	if v, ok := tfMap["allow_credentials"].(bool); ok {
+		apiObject.AllowCredentials = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["allow_headers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowHeaders = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["allow_methods"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowMethods = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["allow_origins"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AllowOrigins = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["expose_headers"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ExposeHeaders = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["max_age"].(int); ok && v != 0 {
+		apiObject.MaxAge = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenFunctionURLCors(apiObject *lambda.Cors) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"allow_credentials": aws.BoolValue(apiObject.AllowCredentials),
+		"allow_headers":     aws.StringValueSlice(apiObject.AllowHeaders),
+		"allow_methods":     aws.StringValueSlice(apiObject.AllowMethods),
+		"allow_origins":     aws.StringValueSlice(apiObject.AllowOrigins),
+		"expose_headers":    aws.StringValueSlice(apiObject.ExposeHeaders),
+		"max_age":           aws.Int64Value(apiObject.MaxAge),
+	}
+
+	return tfMap
+}
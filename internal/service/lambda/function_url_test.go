@@ -0,0 +1,376 @@
+package lambda_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccLambdaFunctionURL_basic(t *testing.T) {
+	var conf lambda.GetFunctionUrlConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function_url.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFunctionURLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionURLBasicConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", lambda.FunctionUrlAuthTypeNone),
+					resource.TestCheckResourceAttr(resourceName, "cors.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "function_arn"),
+					resource.TestCheckResourceAttr(resourceName, "function_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "function_url"),
+					resource.TestCheckResourceAttr(resourceName, "qualifier", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "url_id"),
+					testAccCheckFunctionURLPublicAccessPermission("aws_lambda_function.test", true),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFunctionURLAuthorizationTypeConfig(rName, lambda.FunctionUrlAuthTypeAwsIam),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", lambda.FunctionUrlAuthTypeAwsIam),
+					testAccCheckFunctionURLPublicAccessPermission("aws_lambda_function.test", false),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionURL_disappears(t *testing.T) {
+	var conf lambda.GetFunctionUrlConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function_url.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFunctionURLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionURLBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionURLExists(resourceName, &conf),
+					acctest.CheckResourceDisappears(acctest.Provider, tflambda.ResourceFunctionURL(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionURL_cors(t *testing.T) {
+	var conf lambda.GetFunctionUrlConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function_url.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFunctionURLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionURLCorsConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "authorization_type", lambda.FunctionUrlAuthTypeAwsIam),
+					resource.TestCheckResourceAttr(resourceName, "cors.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_credentials", "true"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_headers.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_headers.*", "date"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_headers.*", "keep-alive"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_methods.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_methods.*", "*"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_origins.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_origins.*", "*"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.expose_headers.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.expose_headers.*", "date"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.expose_headers.*", "keep-alive"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.max_age", "86400"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFunctionURLCorsUpdatedConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "cors.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_credentials", "false"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_headers.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_headers.*", "x-custom-header"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_methods.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_methods.*", "GET"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_methods.*", "POST"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_origins.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_origins.*", "https://www.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.expose_headers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.max_age", "3600"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionURL_alias(t *testing.T) {
+	var conf lambda.GetFunctionUrlConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function_url.test"
+	aliasResourceName := "aws_lambda_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lambda.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckFunctionURLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionURLAliasConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLExists(resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "function_arn", aliasResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "qualifier", aliasResourceName, "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFunctionURLExists(n string, v *lambda.GetFunctionUrlConfigOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lambda Function URL ID is set")
+		}
+
+		name, qualifier, err := tflambda.FunctionURLParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+
+		output, err := tflambda.FindFunctionURLByNameAndQualifier(conn, name, qualifier)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckFunctionURLDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lambda_function_url" {
+			continue
+		}
+
+		name, qualifier, err := tflambda.FunctionURLParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tflambda.FindFunctionURLByNameAndQualifier(conn, name, qualifier)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lambda Function URL %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckFunctionURLPublicAccessPermission(n string, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaConn
+
+		output, err := conn.GetPolicy(&lambda.GetPolicyInput{
+			FunctionName: aws.String(rs.Primary.ID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+			if expected {
+				return fmt.Errorf("Lambda Function (%s) has no resource-based policy", rs.Primary.ID)
+			}
+
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		policy := tflambda.Policy{}
+
+		if err := json.Unmarshal([]byte(aws.StringValue(output.Policy)), &policy); err != nil {
+			return err
+		}
+
+		_, err = tflambda.FindPolicyStatementByID(&policy, "FunctionURLAllowPublicAccess")
+
+		if found := err == nil; found != expected {
+			return fmt.Errorf("Lambda Function (%s) public access permission: expected %t, got %t", rs.Primary.ID, expected, found)
+		}
+
+		return nil
+	}
+}
+
+func testAccFunctionURLBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSLambdaBasicExecutionRole"
+  role       = aws_iam_role.test.id
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.test.arn
+  handler       = "exports.example"
+  runtime       = "nodejs12.x"
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName)
+}
+
+func testAccFunctionURLBasicConfig(rName string) string {
+	return testAccFunctionURLAuthorizationTypeConfig(rName, lambda.FunctionUrlAuthTypeNone)
+}
+
+func testAccFunctionURLAuthorizationTypeConfig(rName, authorizationType string) string {
+	return acctest.ConfigCompose(testAccFunctionURLBaseConfig(rName), fmt.Sprintf(`
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  authorization_type = %[1]q
+}
+`, authorizationType))
+}
+
+func testAccFunctionURLCorsConfig(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionURLBaseConfig(rName), `
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  authorization_type = "AWS_IAM"
+
+  cors {
+    allow_credentials = true
+    allow_origins     = ["*"]
+    allow_methods     = ["*"]
+    allow_headers     = ["date", "keep-alive"]
+    expose_headers    = ["keep-alive", "date"]
+    max_age           = 86400
+  }
+}
+`)
+}
+
+func testAccFunctionURLCorsUpdatedConfig(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionURLBaseConfig(rName), `
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  authorization_type = "AWS_IAM"
+
+  cors {
+    allow_credentials = false
+    allow_origins     = ["https://www.example.com"]
+    allow_methods     = ["GET", "POST"]
+    allow_headers     = ["x-custom-header"]
+    max_age           = 3600
+  }
+}
+`)
+}
+
+func testAccFunctionURLAliasConfig(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionURLBaseConfig(rName), fmt.Sprintf(`
+resource "aws_lambda_alias" "test" {
+  name             = %[1]q
+  function_name    = aws_lambda_function.test.function_name
+  function_version = "$LATEST"
+}
+
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  qualifier          = aws_lambda_alias.test.name
+  authorization_type = "NONE"
+}
+`, rName))
+}
@@ -26,7 +26,11 @@ func ResourceAccount() *schema.Resource {
 		Update: resourceAccountUpdate,
 		Delete: resourceAccountDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceAccountImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -34,6 +38,21 @@ func ResourceAccount() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"close_on_deletion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"create_govcloud": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"govcloud_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"joined_method": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -93,30 +112,11 @@ func resourceAccountCreate(d *schema.ResourceData, meta interface{}) error {
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	// Create the account
-	createOpts := &organizations.CreateAccountInput{
-		AccountName: aws.String(d.Get("name").(string)),
-		Email:       aws.String(d.Get("email").(string)),
-	}
-
-	if role, ok := d.GetOk("role_name"); ok {
-		createOpts.RoleName = aws.String(role.(string))
-	}
-
-	if iam_user, ok := d.GetOk("iam_user_access_to_billing"); ok {
-		createOpts.IamUserAccessToBilling = aws.String(iam_user.(string))
-	}
-
-	if len(tags) > 0 {
-		createOpts.Tags = Tags(tags.IgnoreAWS())
-	}
-
-	log.Printf("[DEBUG] Creating AWS Organizations Account: %s", createOpts)
-
-	var resp *organizations.CreateAccountOutput
+	var status *organizations.CreateAccountStatus
 	err := resource.Retry(4*time.Minute, func() *resource.RetryError {
 		var err error
 
-		resp, err = conn.CreateAccount(createOpts)
+		status, err = resourceAccountCreateAccount(conn, d, tags)
 
 		if tfawserr.ErrMessageContains(err, organizations.ErrCodeFinalizingOrganizationException, "") {
 			return resource.RetryableError(err)
@@ -130,14 +130,14 @@ func resourceAccountCreate(d *schema.ResourceData, meta interface{}) error {
 	})
 
 	if tfresource.TimedOut(err) {
-		resp, err = conn.CreateAccount(createOpts)
+		status, err = resourceAccountCreateAccount(conn, d, tags)
 	}
 
 	if err != nil {
 		return fmt.Errorf("Error creating account: %w", err)
 	}
 
-	requestId := aws.StringValue(resp.CreateAccountStatus.Id)
+	requestId := aws.StringValue(status.Id)
 
 	// Wait for the account to become available
 	log.Printf("[DEBUG] Waiting for account request (%s) to succeed", requestId)
@@ -159,6 +159,7 @@ func resourceAccountCreate(d *schema.ResourceData, meta interface{}) error {
 	// Store the ID
 	accountId := stateResp.(*organizations.CreateAccountStatus).AccountId
 	d.SetId(aws.StringValue(accountId))
+	d.Set("govcloud_id", stateResp.(*organizations.CreateAccountStatus).GovCloudAccountId)
 
 	if v, ok := d.GetOk("parent_id"); ok {
 		newParentID := v.(string)
@@ -276,6 +277,29 @@ func resourceAccountUpdate(d *schema.ResourceData, meta interface{}) error {
 func resourceAccountDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).OrganizationsConn
 
+	if d.Get("close_on_deletion").(bool) {
+		input := &organizations.CloseAccountInput{
+			AccountId: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Closing AWS Organizations Account: %s", input)
+		_, err := conn.CloseAccount(input)
+
+		if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAccountNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error closing AWS Organizations Account (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitAccountClosed(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return fmt.Errorf("error waiting for AWS Organizations Account (%s) close: %w", d.Id(), err)
+		}
+
+		return nil
+	}
+
 	input := &organizations.RemoveAccountFromOrganizationInput{
 		AccountId: aws.String(d.Id()),
 	}
@@ -290,6 +314,71 @@ func resourceAccountDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceAccountImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("close_on_deletion", false)
+	d.Set("create_govcloud", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceAccountCreateAccount requests creation of a member account, paired with an
+// AWS GovCloud (US) account if configured, and returns the status of the request.
+func resourceAccountCreateAccount(conn *organizations.Organizations, d *schema.ResourceData, tags tftags.KeyValueTags) (*organizations.CreateAccountStatus, error) {
+	if d.Get("create_govcloud").(bool) {
+		input := &organizations.CreateGovCloudAccountInput{
+			AccountName: aws.String(d.Get("name").(string)),
+			Email:       aws.String(d.Get("email").(string)),
+		}
+
+		if v, ok := d.GetOk("iam_user_access_to_billing"); ok {
+			input.IamUserAccessToBilling = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("role_name"); ok {
+			input.RoleName = aws.String(v.(string))
+		}
+
+		if len(tags) > 0 {
+			input.Tags = Tags(tags.IgnoreAWS())
+		}
+
+		log.Printf("[DEBUG] Creating AWS Organizations GovCloud Account: %s", input)
+		output, err := conn.CreateGovCloudAccount(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return output.CreateAccountStatus, nil
+	}
+
+	input := &organizations.CreateAccountInput{
+		AccountName: aws.String(d.Get("name").(string)),
+		Email:       aws.String(d.Get("email").(string)),
+	}
+
+	if v, ok := d.GetOk("iam_user_access_to_billing"); ok {
+		input.IamUserAccessToBilling = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_name"); ok {
+		input.RoleName = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating AWS Organizations Account: %s", input)
+	output, err := conn.CreateAccount(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.CreateAccountStatus, nil
+}
+
 // resourceAccountStateRefreshFunc returns a resource.StateRefreshFunc
 // that is used to watch a CreateAccount request
 func resourceAccountStateRefreshFunc(conn *organizations.Organizations, id string) resource.StateRefreshFunc {
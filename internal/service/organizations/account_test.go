@@ -5,6 +5,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -105,6 +106,48 @@ func testAccAccount_ParentID(t *testing.T) {
 	})
 }
 
+func testAccAccount_CloseOnDeletion(t *testing.T) {
+	acctest.Skip(t, "AWS Organizations Account testing is not currently automated due to manual account deletion steps.")
+
+	var account organizations.Account
+
+	orgsEmailDomain, ok := os.LookupEnv("TEST_AWS_ORGANIZATION_ACCOUNT_EMAIL_DOMAIN")
+
+	if !ok {
+		acctest.Skip(t, "'TEST_AWS_ORGANIZATION_ACCOUNT_EMAIL_DOMAIN' not set, skipping test.")
+	}
+
+	rInt := sdkacctest.RandInt()
+	name := fmt.Sprintf("tf_acctest_%d", rInt)
+	email := fmt.Sprintf("tf-acctest+%d@%s", rInt, orgsEmailDomain)
+	resourceName := "aws_organizations_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, organizations.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountCloseOnDeletionConfig(name, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountExists(resourceName, &account),
+					resource.TestCheckResourceAttr(resourceName, "close_on_deletion", "true"),
+					resource.TestCheckResourceAttr(resourceName, "create_govcloud", "false"),
+					resource.TestCheckResourceAttr(resourceName, "govcloud_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "status", organizations.AccountStatusActive),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"close_on_deletion"},
+			},
+		},
+	})
+}
+
 func testAccAccount_Tags(t *testing.T) {
 	acctest.Skip(t, "AWS Organizations Account testing is not currently automated due to manual account deletion steps.")
 
@@ -182,6 +225,11 @@ func testAccCheckAccountDestroy(s *terraform.State) error {
 			return err
 		}
 
+		// Accounts closed on deletion remain visible in the organization for 90 days.
+		if rs.Primary.Attributes["close_on_deletion"] == "true" && resp != nil && resp.Account != nil && aws.StringValue(resp.Account.Status) == organizations.AccountStatusSuspended {
+			continue
+		}
+
 		if resp != nil && resp.Account != nil {
 			return fmt.Errorf("Bad: Account still exists: %q", rs.Primary.ID)
 		}
@@ -272,6 +320,16 @@ resource "aws_organizations_account" "test" {
 `, name, email)
 }
 
+func testAccAccountCloseOnDeletionConfig(name, email string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_account" "test" {
+  name              = %[1]q
+  email             = %[2]q
+  close_on_deletion = true
+}
+`, name, email)
+}
+
 func testAccAccountTags1Config(name, email, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_organizations_organization" "test" {}
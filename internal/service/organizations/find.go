@@ -1,7 +1,9 @@
 package organizations
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -23,3 +25,31 @@ func FindOrganization(conn *organizations.Organizations) (*organizations.Organiz
 
 	return output.Organization, nil
 }
+
+func FindAccountByID(conn *organizations.Organizations, id string) (*organizations.Account, error) {
+	input := &organizations.DescribeAccountInput{
+		AccountId: aws.String(id),
+	}
+
+	output, err := conn.DescribeAccount(input)
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAccountNotFoundException, organizations.ErrCodeAWSOrganizationsNotInUseException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Account == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Account, nil
+}
@@ -0,0 +1,26 @@
+package organizations
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func waitAccountClosed(conn *organizations.Organizations, id string, timeout time.Duration) (*organizations.Account, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{organizations.AccountStatusActive, organizations.AccountStatusPendingClosure},
+		Target:       []string{organizations.AccountStatusSuspended},
+		Refresh:      statusAccountStatus(conn, id),
+		PollInterval: 10 * time.Second,
+		Timeout:      timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*organizations.Account); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -205,6 +205,12 @@ func resourceSecretCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(aws.StringValue(output.ARN))
 
+	if len(input.AddReplicaRegions) > 0 {
+		if _, err := waitSecretReplicationInSync(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for Secrets Manager Secret (%s) replication: %w", d.Id(), err)
+		}
+	}
+
 	if v, ok := d.GetOk("policy"); ok && v.(string) != "" {
 		input := &secretsmanager.PutResourcePolicyInput{
 			ResourcePolicy: aws.String(v.(string)),
@@ -377,6 +383,12 @@ func resourceSecretUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return fmt.Errorf("error adding Secrets Manager Secret replica: %w", err)
 		}
+
+		if ns.Difference(os).Len() > 0 {
+			if _, err := waitSecretReplicationInSync(conn, d.Id()); err != nil {
+				return fmt.Errorf("error waiting for Secrets Manager Secret (%s) replication: %w", d.Id(), err)
+			}
+		}
 	}
 
 	if d.HasChanges("description", "kms_key_id") {
@@ -570,7 +582,7 @@ func addSecretsManagerSecretReplicas(conn *secretsmanager.SecretsManager, id str
 		AddReplicaRegions:           expandSecretsManagerSecretReplicas(tfList),
 	}
 
-	log.Printf("[DEBUG] Removing Secrets Manager Secret Replica: %s", input)
+	log.Printf("[DEBUG] Adding Secrets Manager Secret Replicas: %s", input)
 
 	_, err := conn.ReplicateSecretToRegions(input)
 
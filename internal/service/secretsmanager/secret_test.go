@@ -137,6 +137,9 @@ func TestAccSecretsManagerSecret_basicReplica(t *testing.T) {
 					testAccCheckSecretExists(resourceName, &secret),
 					resource.TestCheckResourceAttr(resourceName, "force_overwrite_replica_secret", "false"),
 					resource.TestCheckResourceAttr(resourceName, "replica.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*", map[string]string{
+						"status": secretsmanager.StatusTypeInSync,
+					}),
 				),
 			},
 		},
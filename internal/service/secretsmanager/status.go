@@ -0,0 +1,37 @@
+package secretsmanager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// statusSecretReplication returns the aggregate replication status of all of a secret's replicas:
+// Failed if any replica failed, InProgress if any replica is still replicating, otherwise InSync.
+func statusSecretReplication(conn *secretsmanager.SecretsManager, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSecretByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := secretsmanager.StatusTypeInSync
+
+		for _, v := range output.ReplicationStatus {
+			switch aws.StringValue(v.Status) {
+			case secretsmanager.StatusTypeFailed:
+				return output, secretsmanager.StatusTypeFailed, nil
+			case secretsmanager.StatusTypeInProgress:
+				status = secretsmanager.StatusTypeInProgress
+			}
+		}
+
+		return output, status, nil
+	}
+}
@@ -1,10 +1,50 @@
 package secretsmanager
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
 	// Maximum amount of time to wait for Secrets Manager changes to propagate
 	PropagationTimeout = 2 * time.Minute
+
+	// Maximum amount of time to wait for Secrets Manager replicas to be in sync
+	ReplicationTimeout = 10 * time.Minute
 )
+
+func waitSecretReplicationInSync(conn *secretsmanager.SecretsManager, id string) (*secretsmanager.DescribeSecretOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{secretsmanager.StatusTypeInProgress},
+		Target:  []string{secretsmanager.StatusTypeInSync},
+		Refresh: statusSecretReplication(conn, id),
+		Timeout: ReplicationTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*secretsmanager.DescribeSecretOutput); ok {
+		var messages []string
+
+		for _, v := range output.ReplicationStatus {
+			if aws.StringValue(v.Status) == secretsmanager.StatusTypeFailed {
+				messages = append(messages, fmt.Sprintf("%s: %s", aws.StringValue(v.Region), aws.StringValue(v.StatusMessage)))
+			}
+		}
+
+		if len(messages) > 0 {
+			tfresource.SetLastError(err, errors.New(strings.Join(messages, "; ")))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -1,8 +1,10 @@
 package sfn
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"sort"
 )
 
 func validStateMachineName(v interface{}, k string) (ws []string, errors []error) {
@@ -17,3 +19,398 @@ func validStateMachineName(v interface{}, k string) (ws []string, errors []error
 	}
 	return
 }
+
+// validStateMachineDefinition performs a structural check of an Amazon States Language
+// definition so that common mistakes are reported at plan time rather than by CreateStateMachine.
+// See https://states-language.net/spec.html.
+func validStateMachineDefinition(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	var definition map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &definition); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %w", k, err))
+		return
+	}
+
+	for _, err := range validateStateMachineStates(definition, "", queryLanguageJSONPath) {
+		errors = append(errors, fmt.Errorf("%q is not a valid Amazon States Language definition: %w", k, err))
+	}
+	return
+}
+
+const (
+	queryLanguageJSONata  = "JSONata"
+	queryLanguageJSONPath = "JSONPath"
+)
+
+const (
+	stateTypeChoice   = "Choice"
+	stateTypeFail     = "Fail"
+	stateTypeMap      = "Map"
+	stateTypeParallel = "Parallel"
+	stateTypePass     = "Pass"
+	stateTypeSucceed  = "Succeed"
+	stateTypeTask     = "Task"
+	stateTypeWait     = "Wait"
+)
+
+func stateType_Values() []string {
+	return []string{
+		stateTypeChoice,
+		stateTypeFail,
+		stateTypeMap,
+		stateTypeParallel,
+		stateTypePass,
+		stateTypeSucceed,
+		stateTypeTask,
+		stateTypeWait,
+	}
+}
+
+var choiceRuleComparisonOperators = map[string]bool{
+	"BooleanEquals":                  true,
+	"BooleanEqualsPath":              true,
+	"IsBoolean":                      true,
+	"IsNull":                         true,
+	"IsNumeric":                      true,
+	"IsPresent":                      true,
+	"IsString":                       true,
+	"IsTimestamp":                    true,
+	"NumericEquals":                  true,
+	"NumericEqualsPath":              true,
+	"NumericGreaterThan":             true,
+	"NumericGreaterThanEquals":       true,
+	"NumericGreaterThanEqualsPath":   true,
+	"NumericGreaterThanPath":         true,
+	"NumericLessThan":                true,
+	"NumericLessThanEquals":          true,
+	"NumericLessThanEqualsPath":      true,
+	"NumericLessThanPath":            true,
+	"StringEquals":                   true,
+	"StringEqualsPath":               true,
+	"StringGreaterThan":              true,
+	"StringGreaterThanEquals":        true,
+	"StringGreaterThanEqualsPath":    true,
+	"StringGreaterThanPath":          true,
+	"StringLessThan":                 true,
+	"StringLessThanEquals":           true,
+	"StringLessThanEqualsPath":       true,
+	"StringLessThanPath":             true,
+	"StringMatches":                  true,
+	"TimestampEquals":                true,
+	"TimestampEqualsPath":            true,
+	"TimestampGreaterThan":           true,
+	"TimestampGreaterThanEquals":     true,
+	"TimestampGreaterThanEqualsPath": true,
+	"TimestampGreaterThanPath":       true,
+	"TimestampLessThan":              true,
+	"TimestampLessThanEquals":        true,
+	"TimestampLessThanEqualsPath":    true,
+	"TimestampLessThanPath":          true,
+}
+
+// validateStateMachineStates validates a top-level state machine, a Parallel branch or a Map iterator.
+// path identifies the enclosing state in error messages and is empty for the top-level state machine.
+// queryLanguage is the query language inherited from the enclosing state.
+func validateStateMachineStates(machine map[string]interface{}, path, queryLanguage string) []error {
+	var errs []error
+
+	queryLanguage = effectiveQueryLanguage(machine, queryLanguage)
+
+	startAt, ok := machine["StartAt"].(string)
+	if !ok || startAt == "" {
+		errs = append(errs, fmt.Errorf("%sStartAt must be a non-empty string", path))
+	}
+
+	states, ok := machine["States"].(map[string]interface{})
+	if !ok || len(states) == 0 {
+		errs = append(errs, fmt.Errorf("%sStates must be a non-empty object", path))
+		return errs
+	}
+
+	if startAt != "" {
+		if _, ok := states[startAt]; !ok {
+			errs = append(errs, fmt.Errorf("%sStartAt refers to missing state %q", path, startAt))
+		}
+	}
+
+	names := make([]string, 0, len(states))
+	for name := range states {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	transitions := make(map[string][]string, len(states))
+
+	for _, name := range names {
+		statePath := fmt.Sprintf("%sStates.%s: ", path, name)
+
+		state, ok := states[name].(map[string]interface{})
+		if !ok {
+			errs = append(errs, fmt.Errorf("%sstate must be an object", statePath))
+			continue
+		}
+
+		next, stateErrs := validateState(state, statePath, queryLanguage)
+		errs = append(errs, stateErrs...)
+
+		for _, target := range next {
+			if _, ok := states[target]; !ok {
+				errs = append(errs, fmt.Errorf("%stransition to missing state %q", statePath, target))
+			}
+		}
+
+		transitions[name] = next
+	}
+
+	if _, ok := states[startAt]; !ok {
+		return errs
+	}
+
+	reachable := map[string]bool{startAt: true}
+	queue := []string{startAt}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		for _, target := range transitions[name] {
+			if _, ok := states[target]; ok && !reachable[target] {
+				reachable[target] = true
+				queue = append(queue, target)
+			}
+		}
+	}
+
+	for _, name := range names {
+		if !reachable[name] {
+			errs = append(errs, fmt.Errorf("%sStates.%s: state is not reachable from %q", path, name, startAt))
+		}
+	}
+
+	return errs
+}
+
+// validateState validates a single state and returns the names of the states it may transition to.
+func validateState(state map[string]interface{}, path, queryLanguage string) ([]string, []error) {
+	var errs []error
+	var next []string
+
+	queryLanguage = effectiveQueryLanguage(state, queryLanguage)
+
+	stateType, _ := state["Type"].(string)
+
+	switch stateType {
+	case stateTypeChoice:
+		if _, ok := state["Next"]; ok {
+			errs = append(errs, fmt.Errorf("%s%s state cannot have Next", path, stateType))
+		}
+		if _, ok := state["End"]; ok {
+			errs = append(errs, fmt.Errorf("%s%s state cannot have End", path, stateType))
+		}
+
+		choices, ok := state["Choices"].([]interface{})
+		if !ok || len(choices) == 0 {
+			errs = append(errs, fmt.Errorf("%sChoices must be a non-empty array", path))
+		}
+
+		for i, v := range choices {
+			rulePath := fmt.Sprintf("%sChoices[%d]", path, i)
+
+			rule, ok := v.(map[string]interface{})
+			if !ok {
+				errs = append(errs, fmt.Errorf("%s: choice rule must be an object", rulePath))
+				continue
+			}
+
+			errs = append(errs, validateChoiceRule(rule, rulePath, true, queryLanguage)...)
+
+			if v, ok := rule["Next"].(string); ok {
+				next = append(next, v)
+			}
+		}
+
+		if v, ok := state["Default"]; ok {
+			if v, ok := v.(string); ok && v != "" {
+				next = append(next, v)
+			} else {
+				errs = append(errs, fmt.Errorf("%sDefault must be a non-empty string", path))
+			}
+		}
+
+	case stateTypeFail, stateTypeSucceed:
+		if _, ok := state["Next"]; ok {
+			errs = append(errs, fmt.Errorf("%s%s state cannot have Next", path, stateType))
+		}
+		if _, ok := state["End"]; ok {
+			errs = append(errs, fmt.Errorf("%s%s state cannot have End", path, stateType))
+		}
+
+	case stateTypeMap, stateTypeParallel, stateTypePass, stateTypeTask, stateTypeWait:
+		v, hasNext := state["Next"]
+		end, hasEnd := state["End"]
+
+		switch {
+		case hasNext && hasEnd:
+			errs = append(errs, fmt.Errorf("%sonly one of Next or End can be specified", path))
+		case hasNext:
+			if v, ok := v.(string); ok && v != "" {
+				next = append(next, v)
+			} else {
+				errs = append(errs, fmt.Errorf("%sNext must be a non-empty string", path))
+			}
+		case hasEnd:
+			if v, ok := end.(bool); !ok || !v {
+				errs = append(errs, fmt.Errorf("%sEnd must be true", path))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("%sone of Next or End must be specified", path))
+		}
+
+	default:
+		errs = append(errs, fmt.Errorf("%sType must be one of %v, got %q", path, stateType_Values(), stateType))
+		return nil, errs
+	}
+
+	switch stateType {
+	case stateTypeMap, stateTypeParallel, stateTypeTask:
+		if v, ok := state["Catch"]; ok {
+			catchers, ok := v.([]interface{})
+			if !ok {
+				errs = append(errs, fmt.Errorf("%sCatch must be an array", path))
+				break
+			}
+
+			for i, v := range catchers {
+				catcher, ok := v.(map[string]interface{})
+				if !ok {
+					errs = append(errs, fmt.Errorf("%sCatch[%d]: catcher must be an object", path, i))
+					continue
+				}
+
+				if v, ok := catcher["Next"].(string); ok && v != "" {
+					next = append(next, v)
+				} else {
+					errs = append(errs, fmt.Errorf("%sCatch[%d]: Next must be a non-empty string", path, i))
+				}
+			}
+		}
+	}
+
+	switch stateType {
+	case stateTypeMap:
+		key := "ItemProcessor"
+		if _, ok := state[key]; !ok {
+			key = "Iterator"
+		}
+
+		if iterator, ok := state[key].(map[string]interface{}); ok {
+			errs = append(errs, validateStateMachineStates(iterator, path+key+".", queryLanguage)...)
+		} else {
+			errs = append(errs, fmt.Errorf("%sone of ItemProcessor or Iterator must be specified", path))
+		}
+
+	case stateTypeParallel:
+		branches, ok := state["Branches"].([]interface{})
+		if !ok || len(branches) == 0 {
+			errs = append(errs, fmt.Errorf("%sBranches must be a non-empty array", path))
+		}
+
+		for i, v := range branches {
+			branch, ok := v.(map[string]interface{})
+			if !ok {
+				errs = append(errs, fmt.Errorf("%sBranches[%d]: branch must be an object", path, i))
+				continue
+			}
+
+			errs = append(errs, validateStateMachineStates(branch, fmt.Sprintf("%sBranches[%d].", path, i), queryLanguage)...)
+		}
+	}
+
+	return next, errs
+}
+
+// effectiveQueryLanguage returns the QueryLanguage of a state machine or state,
+// or the inherited query language if it is not set.
+func effectiveQueryLanguage(v map[string]interface{}, inherited string) string {
+	if v, ok := v["QueryLanguage"].(string); ok && v != "" {
+		return v
+	}
+
+	return inherited
+}
+
+// validateChoiceRule validates a Choice rule. Only top-level rules may (and must) have Next;
+// rules nested in And, Or or Not must not.
+// JSONata rules use a Condition expression in place of Variable and the operators, which is not validated.
+func validateChoiceRule(rule map[string]interface{}, path string, topLevel bool, queryLanguage string) []error {
+	var errs []error
+
+	if _, ok := rule["Next"]; topLevel && !ok {
+		errs = append(errs, fmt.Errorf("%s: Next must be specified", path))
+	} else if ok && !topLevel {
+		errs = append(errs, fmt.Errorf("%s: nested choice rule cannot have Next", path))
+	} else if ok {
+		if v, ok := rule["Next"].(string); !ok || v == "" {
+			errs = append(errs, fmt.Errorf("%s: Next must be a non-empty string", path))
+		}
+	}
+
+	if queryLanguage == queryLanguageJSONata {
+		return errs
+	}
+
+	var operators []string
+	for k := range rule {
+		switch k {
+		case "And", "Not", "Or":
+			operators = append(operators, k)
+		default:
+			if choiceRuleComparisonOperators[k] {
+				operators = append(operators, k)
+			}
+		}
+	}
+	sort.Strings(operators)
+
+	if len(operators) != 1 {
+		errs = append(errs, fmt.Errorf("%s: exactly one of And, Or, Not or a comparison operator must be specified, got %v", path, operators))
+		return errs
+	}
+
+	switch operator := operators[0]; operator {
+	case "And", "Or":
+		rules, ok := rule[operator].([]interface{})
+		if !ok || len(rules) == 0 {
+			errs = append(errs, fmt.Errorf("%s: %s must be a non-empty array", path, operator))
+			break
+		}
+
+		for i, v := range rules {
+			nestedPath := fmt.Sprintf("%s.%s[%d]", path, operator, i)
+
+			nested, ok := v.(map[string]interface{})
+			if !ok {
+				errs = append(errs, fmt.Errorf("%s: choice rule must be an object", nestedPath))
+				continue
+			}
+
+			errs = append(errs, validateChoiceRule(nested, nestedPath, false, queryLanguage)...)
+		}
+
+	case "Not":
+		nested, ok := rule[operator].(map[string]interface{})
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: Not must be an object", path))
+			break
+		}
+
+		errs = append(errs, validateChoiceRule(nested, path+".Not", false, queryLanguage)...)
+
+	default:
+		if v, ok := rule["Variable"].(string); !ok || v == "" {
+			errs = append(errs, fmt.Errorf("%s: Variable must be specified with %s", path, operator))
+		}
+	}
+
+	return errs
+}
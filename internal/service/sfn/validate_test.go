@@ -49,3 +49,277 @@ func TestValidStateMachineName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidStateMachineDefinition(t *testing.T) {
+	testCases := []struct {
+		name       string
+		definition string
+		errCount   int
+	}{
+		{
+			name:       "invalid JSON",
+			definition: `{"StartAt": "A",`,
+			errCount:   1,
+		},
+		{
+			name: "minimal",
+			definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {"Type": "Pass", "End": true}
+  }
+}`,
+		},
+		{
+			name:       "missing StartAt and States",
+			definition: `{}`,
+			errCount:   2,
+		},
+		{
+			name: "StartAt refers to missing state",
+			definition: `{
+  "StartAt": "B",
+  "States": {
+    "A": {"Type": "Pass", "End": true}
+  }
+}`,
+			errCount: 1,
+		},
+		{
+			name: "invalid Type",
+			definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {"Type": "Lambda", "End": true}
+  }
+}`,
+			errCount: 1,
+		},
+		{
+			name: "both Next and End",
+			definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {"Type": "Pass", "Next": "B", "End": true},
+    "B": {"Type": "Succeed"}
+  }
+}`,
+			errCount: 2,
+		},
+		{
+			name: "neither Next nor End",
+			definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {"Type": "Task", "Resource": "arn:aws:states:::lambda:invoke"}
+  }
+}`,
+			errCount: 1,
+		},
+		{
+			name: "End false",
+			definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {"Type": "Wait", "Seconds": 10, "End": false}
+  }
+}`,
+			errCount: 1,
+		},
+		{
+			name: "terminal state with Next",
+			definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {"Type": "Pass", "Next": "B"},
+    "B": {"Type": "Fail", "Next": "A"}
+  }
+}`,
+			errCount: 1,
+		},
+		{
+			name: "Next refers to missing state",
+			definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {"Type": "Pass", "Next": "C"},
+    "B": {"Type": "Succeed"}
+  }
+}`,
+			errCount: 2,
+		},
+		{
+			name: "unreachable state",
+			definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {"Type": "Pass", "End": true},
+    "B": {"Type": "Pass", "Next": "C"},
+    "C": {"Type": "Succeed"}
+  }
+}`,
+			errCount: 2,
+		},
+		{
+			name: "Catch makes state reachable",
+			definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "B"}],
+      "End": true
+    },
+    "B": {"Type": "Fail"}
+  }
+}`,
+		},
+		{
+			name: "valid Choice",
+			definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {
+      "Type": "Choice",
+      "Choices": [
+        {"Variable": "$.x", "NumericGreaterThan": 1, "Next": "B"},
+        {
+          "And": [
+            {"Variable": "$.y", "IsPresent": true},
+            {"Not": {"Variable": "$.y", "StringEquals": "z"}}
+          ],
+          "Next": "C"
+        }
+      ],
+      "Default": "D"
+    },
+    "B": {"Type": "Succeed"},
+    "C": {"Type": "Succeed"},
+    "D": {"Type": "Fail"}
+  }
+}`,
+		},
+		{
+			name: "Choice with End",
+			definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {
+      "Type": "Choice",
+      "Choices": [{"Variable": "$.x", "BooleanEquals": true, "Next": "B"}],
+      "End": true
+    },
+    "B": {"Type": "Succeed"}
+  }
+}`,
+			errCount: 1,
+		},
+		{
+			name: "Choice with empty Choices",
+			definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {"Type": "Choice", "Choices": [], "Default": "B"},
+    "B": {"Type": "Succeed"}
+  }
+}`,
+			errCount: 1,
+		},
+		{
+			name: "Choice rule structure",
+			definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {
+      "Type": "Choice",
+      "Choices": [
+        {"NumericEquals": 1, "Next": "B"},
+        {"Variable": "$.x", "Next": "B"},
+        {"Variable": "$.x", "StringEquals": "a", "BooleanEquals": true, "Next": "B"},
+        {"Variable": "$.x", "StringEquals": "a"},
+        {"Or": [{"Variable": "$.x", "IsNull": true, "Next": "B"}], "Next": "B"}
+      ]
+    },
+    "B": {"Type": "Succeed"}
+  }
+}`,
+			errCount: 5,
+		},
+		{
+			name: "JSONata Choice",
+			definition: `{
+  "QueryLanguage": "JSONata",
+  "StartAt": "A",
+  "States": {
+    "A": {
+      "Type": "Choice",
+      "Choices": [
+        {"Condition": "{% $states.input.x = 1 %}", "Next": "B"},
+        {"Condition": "{% $states.input.x > 1 %}"}
+      ],
+      "Default": "B"
+    },
+    "B": {"Type": "Succeed"}
+  }
+}`,
+			errCount: 1,
+		},
+		{
+			name: "JSONata Choice state",
+			definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {
+      "Type": "Choice",
+      "QueryLanguage": "JSONata",
+      "Choices": [
+        {"Condition": "{% $states.input.x = 1 %}", "Next": "B"}
+      ],
+      "Default": "C"
+    },
+    "B": {
+      "Type": "Choice",
+      "Choices": [
+        {"Condition": "{% $states.input.x = 1 %}", "Next": "C"}
+      ]
+    },
+    "C": {"Type": "Succeed"}
+  }
+}`,
+			errCount: 1,
+		},
+		{
+			name: "Parallel and Map",
+			definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {
+      "Type": "Parallel",
+      "Branches": [
+        {"StartAt": "A1", "States": {"A1": {"Type": "Pass", "End": true}}},
+        {"StartAt": "A2", "States": {"A2": {"Type": "Pass", "Next": "Missing"}}}
+      ],
+      "Next": "B"
+    },
+    "B": {
+      "Type": "Map",
+      "ItemProcessor": {"StartAt": "B1", "States": {"B1": {"Type": "Pass"}}},
+      "End": true
+    }
+  }
+}`,
+			errCount: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, errors := validStateMachineDefinition(testCase.definition, "definition")
+
+			if got, want := len(errors), testCase.errCount; got != want {
+				t.Errorf("got %d errors, expected %d: %v", got, want, errors)
+			}
+		})
+	}
+}
@@ -36,6 +36,20 @@ func ResourceTopic() *schema.Resource {
 		),
 
 		Schema: map[string]*schema.Schema{
+			"archive_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"beginning_archive_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"name": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -219,6 +233,16 @@ func resourceTopicCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(aws.StringValue(output.TopicArn))
 
 	// update mutable attributes
+	if d.HasChange("archive_policy") {
+		_, v := d.GetChange("archive_policy")
+		// An empty archive policy disables message archiving.
+		if v == "" {
+			v = "{}"
+		}
+		if err := updateTopicAttribute(d.Id(), "ArchivePolicy", v, conn); err != nil {
+			return err
+		}
+	}
 	if d.HasChange("application_failure_feedback_role_arn") {
 		_, v := d.GetChange("application_failure_feedback_role_arn")
 		if err := updateTopicAttribute(d.Id(), "ApplicationFailureFeedbackRoleArn", v, conn); err != nil {
@@ -353,6 +377,16 @@ func resourceTopicUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SNSConn
 
 	// update mutable attributes
+	if d.HasChange("archive_policy") {
+		_, v := d.GetChange("archive_policy")
+		// An empty archive policy disables message archiving.
+		if v == "" {
+			v = "{}"
+		}
+		if err := updateTopicAttribute(d.Id(), "ArchivePolicy", v, conn); err != nil {
+			return err
+		}
+	}
 	if d.HasChange("application_failure_feedback_role_arn") {
 		_, v := d.GetChange("application_failure_feedback_role_arn")
 		if err := updateTopicAttribute(d.Id(), "ApplicationFailureFeedbackRoleArn", v, conn); err != nil {
@@ -518,6 +552,12 @@ func resourceTopicRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("application_failure_feedback_role_arn", attributeOutput.Attributes["ApplicationFailureFeedbackRoleArn"])
 		d.Set("application_success_feedback_role_arn", attributeOutput.Attributes["ApplicationSuccessFeedbackRoleArn"])
 		d.Set("arn", attributeOutput.Attributes["TopicArn"])
+		if v := aws.StringValue(attributeOutput.Attributes["ArchivePolicy"]); v != "{}" {
+			d.Set("archive_policy", v)
+		} else {
+			d.Set("archive_policy", "")
+		}
+		d.Set("beginning_archive_time", attributeOutput.Attributes["BeginningArchiveTime"])
 		d.Set("delivery_policy", attributeOutput.Attributes["DeliveryPolicy"])
 		d.Set("display_name", attributeOutput.Attributes["DisplayName"])
 		d.Set("http_failure_feedback_role_arn", attributeOutput.Attributes["HTTPFailureFeedbackRoleArn"])
@@ -679,6 +719,10 @@ func resourceTopicCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, me
 		return fmt.Errorf("content-based deduplication can only be set for FIFO topics")
 	}
 
+	if !fifoTopic && diff.Get("archive_policy").(string) != "" {
+		return fmt.Errorf("message archiving can only be set for FIFO topics")
+	}
+
 	return nil
 }
 
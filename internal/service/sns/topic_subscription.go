@@ -106,6 +106,16 @@ func ResourceTopicSubscription() *schema.Resource {
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
 			},
+			"replay_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+			},
+			"replay_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"subscription_role_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -218,6 +228,8 @@ func resourceTopicSubscriptionRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("owner_id", attributes["Owner"])
 	d.Set("protocol", attributes["Protocol"])
 	d.Set("redrive_policy", attributes["RedrivePolicy"])
+	d.Set("replay_policy", attributes["ReplayPolicy"])
+	d.Set("replay_status", attributes["ReplayStatus"])
 	d.Set("subscription_role_arn", attributes["SubscriptionRoleArn"])
 	d.Set("topic_arn", attributes["TopicArn"])
 
@@ -288,6 +300,12 @@ func resourceTopicSubscriptionUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("replay_policy") {
+		if err := snsSubscriptionAttributeUpdate(conn, d.Id(), "ReplayPolicy", d.Get("replay_policy").(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceTopicSubscriptionRead(d, meta)
 }
 
@@ -321,6 +339,7 @@ func expandSNSSubscriptionAttributes(d *schema.ResourceData) (output map[string]
 	filterPolicy := d.Get("filter_policy").(string)
 	rawMessageDelivery := d.Get("raw_message_delivery").(bool)
 	redrivePolicy := d.Get("redrive_policy").(string)
+	replayPolicy := d.Get("replay_policy").(string)
 	subscriptionRoleARN := d.Get("subscription_role_arn").(string)
 
 	// Collect attributes if available
@@ -346,6 +365,10 @@ func expandSNSSubscriptionAttributes(d *schema.ResourceData) (output map[string]
 		attributes["RedrivePolicy"] = aws.String(redrivePolicy)
 	}
 
+	if replayPolicy != "" {
+		attributes["ReplayPolicy"] = aws.String(replayPolicy)
+	}
+
 	return attributes
 }
 
@@ -356,9 +379,9 @@ func snsSubscriptionAttributeUpdate(conn *sns.SNS, subscriptionArn, attributeNam
 		AttributeValue:  aws.String(attributeValue),
 	}
 
-	// The AWS API requires a non-empty string value or nil for the RedrivePolicy attribute,
-	// else throws an InvalidParameter error
-	if attributeName == "RedrivePolicy" && attributeValue == "" {
+	// The AWS API requires a non-empty string value or nil for the RedrivePolicy and
+	// ReplayPolicy attributes, else throws an InvalidParameter error
+	if (attributeName == "RedrivePolicy" || attributeName == "ReplayPolicy") && attributeValue == "" {
 		req.AttributeValue = nil
 	}
 
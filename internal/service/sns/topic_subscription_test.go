@@ -214,6 +214,39 @@ func TestAccSNSTopicSubscription_deliveryPolicy(t *testing.T) {
 	})
 }
 
+func TestAccSNSTopicSubscription_replayPolicy(t *testing.T) {
+	attributes := make(map[string]string)
+	resourceName := "aws_sns_topic_subscription.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sns.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTopicSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicSubscriptionConfig_replayPolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicSubscriptionExists(resourceName, attributes),
+					resource.TestCheckResourceAttrSet(resourceName, "replay_policy"),
+					resource.TestCheckResourceAttrSet(resourceName, "replay_status"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"confirmation_timeout_in_minutes",
+					"endpoint_auto_confirms",
+					"replay_status",
+				},
+			},
+		},
+	})
+}
+
 func TestAccSNSTopicSubscription_redrivePolicy(t *testing.T) {
 	attributes := make(map[string]string)
 	resourceName := "aws_sns_topic_subscription.test"
@@ -696,6 +729,35 @@ resource "aws_sns_topic_subscription" "test" {
 `, rName, dlqName)
 }
 
+func testAccTopicSubscriptionConfig_replayPolicy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name       = "%[1]s.fifo"
+  fifo_topic = true
+
+  archive_policy = jsonencode({
+    MessageRetentionPeriod = 30
+  })
+}
+
+resource "aws_sqs_queue" "test" {
+  name       = "%[1]s.fifo"
+  fifo_queue = true
+}
+
+resource "aws_sns_topic_subscription" "test" {
+  endpoint  = aws_sqs_queue.test.arn
+  protocol  = "sqs"
+  topic_arn = aws_sns_topic.test.arn
+
+  replay_policy = jsonencode({
+    PointType     = "Timestamp"
+    StartingPoint = aws_sns_topic.test.beginning_archive_time
+  })
+}
+`, rName)
+}
+
 func testAccTopicSubscriptionConfig_rawMessageDelivery(rName string, rawMessageDelivery bool) string {
 	return fmt.Sprintf(`
 resource "aws_sns_topic" "test" {
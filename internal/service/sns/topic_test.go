@@ -395,6 +395,66 @@ func TestAccSNSTopic_fifoExpectContentBasedDeduplicationError(t *testing.T) {
 	})
 }
 
+func TestAccSNSTopic_fifoWithArchivePolicy(t *testing.T) {
+	attributes := make(map[string]string)
+	resourceName := "aws_sns_topic.test"
+	rName := sdkacctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sns.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicWithFIFOArchivePolicyConfig(rName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(resourceName, attributes),
+					resource.TestCheckResourceAttr(resourceName, "archive_policy", `{"MessageRetentionPeriod":30}`),
+					resource.TestCheckResourceAttrSet(resourceName, "beginning_archive_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Test attribute update
+			{
+				Config: testAccTopicWithFIFOArchivePolicyConfig(rName, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(resourceName, attributes),
+					resource.TestCheckResourceAttr(resourceName, "archive_policy", `{"MessageRetentionPeriod":60}`),
+				),
+			},
+			// Test attribute removal
+			{
+				Config: testAccTopicWithFIFOContentBasedDeduplicationConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(resourceName, attributes),
+					resource.TestCheckResourceAttr(resourceName, "archive_policy", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSNSTopic_fifoExpectArchivePolicyError(t *testing.T) {
+	rName := sdkacctest.RandString(10)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sns.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTopicExpectArchivePolicyError(rName),
+				ExpectError: regexp.MustCompile(`message archiving can only be set for FIFO topics`),
+			},
+		},
+	})
+}
+
 func TestAccSNSTopic_encryption(t *testing.T) {
 	attributes := make(map[string]string)
 	resourceName := "aws_sns_topic.test"
@@ -915,6 +975,31 @@ resource "aws_sns_topic" "test" {
 `, r, cbd)
 }
 
+func testAccTopicWithFIFOArchivePolicyConfig(r string, retentionPeriod int) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name       = "terraform-test-topic-%s.fifo"
+  fifo_topic = true
+
+  archive_policy = jsonencode({
+    MessageRetentionPeriod = %d
+  })
+}
+`, r, retentionPeriod)
+}
+
+func testAccTopicExpectArchivePolicyError(r string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = "terraform-test-topic-%s"
+
+  archive_policy = jsonencode({
+    MessageRetentionPeriod = 30
+  })
+}
+`, r)
+}
+
 func testAccTopicExpectContentBasedDeduplicationError(r string) string {
 	return fmt.Sprintf(`
 resource "aws_sns_topic" "test" {
@@ -126,9 +126,21 @@ var (
 			Default:  DefaultQueueReceiveMessageWaitTimeSeconds,
 		},
 
+		"redrive_allow_policy": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsJSON,
+			StateFunc: func(v interface{}) string {
+				json, _ := structure.NormalizeJsonString(v)
+				return json
+			},
+		},
+
 		"redrive_policy": {
 			Type:         schema.TypeString,
 			Optional:     true,
+			Computed:     true,
 			ValidateFunc: validation.StringIsJSON,
 			StateFunc: func(v interface{}) string {
 				json, _ := structure.NormalizeJsonString(v)
@@ -159,6 +171,7 @@ var (
 		"receive_wait_time_seconds":         sqs.QueueAttributeNameReceiveMessageWaitTimeSeconds,
 		"visibility_timeout_seconds":        sqs.QueueAttributeNameVisibilityTimeout,
 		"policy":                            sqs.QueueAttributeNamePolicy,
+		"redrive_allow_policy":              sqs.QueueAttributeNameRedriveAllowPolicy,
 		"redrive_policy":                    sqs.QueueAttributeNameRedrivePolicy,
 		"arn":                               sqs.QueueAttributeNameQueueArn,
 		"fifo_queue":                        sqs.QueueAttributeNameFifoQueue,
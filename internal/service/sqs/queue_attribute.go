@@ -0,0 +1,115 @@
+package sqs
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// queueJSONAttributeHandler implements the CRUD operations of a resource that manages
+// a single JSON-valued attribute of an existing queue, independently of aws_sqs_queue.
+type queueJSONAttributeHandler struct {
+	AttributeName string
+	Description   string
+	SchemaKey     string
+}
+
+func (h *queueJSONAttributeHandler) Upsert(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	value, err := structure.NormalizeJsonString(d.Get(h.SchemaKey).(string))
+
+	if err != nil {
+		return fmt.Errorf("%s (%s) is invalid JSON: %w", h.SchemaKey, value, err)
+	}
+
+	attributes := map[string]string{
+		h.AttributeName: value,
+	}
+	url := d.Get("queue_url").(string)
+	input := &sqs.SetQueueAttributesInput{
+		Attributes: aws.StringMap(attributes),
+		QueueUrl:   aws.String(url),
+	}
+
+	log.Printf("[DEBUG] Setting SQS Queue %s: %s", h.Description, input)
+	_, err = conn.SetQueueAttributes(input)
+
+	if err != nil {
+		return fmt.Errorf("error setting SQS Queue %s (%s): %w", h.Description, url, err)
+	}
+
+	d.SetId(url)
+
+	err = waitQueueAttributesPropagated(conn, d.Id(), attributes)
+
+	if err != nil {
+		return fmt.Errorf("error waiting for SQS Queue %s (%s) to be set: %w", h.Description, d.Id(), err)
+	}
+
+	return h.Read(d, meta)
+}
+
+func (h *queueJSONAttributeHandler) Read(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	output, err := FindQueueAttributeByURL(conn, d.Id(), h.AttributeName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SQS Queue %s (%s) not found, removing from state", h.Description, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SQS Queue %s (%s): %w", h.Description, d.Id(), err)
+	}
+
+	value, err := structure.NormalizeJsonString(output)
+
+	if err != nil {
+		return fmt.Errorf("SQS Queue %s (%s) is invalid JSON: %w", h.Description, output, err)
+	}
+
+	d.Set(h.SchemaKey, value)
+	d.Set("queue_url", d.Id())
+
+	return nil
+}
+
+func (h *queueJSONAttributeHandler) Delete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SQSConn
+
+	attributes := map[string]string{
+		h.AttributeName: "",
+	}
+
+	log.Printf("[DEBUG] Deleting SQS Queue %s: %s", h.Description, d.Id())
+	_, err := conn.SetQueueAttributes(&sqs.SetQueueAttributesInput{
+		Attributes: aws.StringMap(attributes),
+		QueueUrl:   aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sqs.ErrCodeQueueDoesNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SQS Queue %s (%s): %w", h.Description, d.Id(), err)
+	}
+
+	err = waitQueueAttributesPropagated(conn, d.Id(), attributes)
+
+	if err != nil {
+		return fmt.Errorf("error waiting for SQS Queue %s (%s) to delete: %w", h.Description, d.Id(), err)
+	}
+
+	return nil
+}
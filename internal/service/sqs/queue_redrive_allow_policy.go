@@ -0,0 +1,43 @@
+package sqs
+
+import (
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceQueueRedriveAllowPolicy() *schema.Resource {
+	h := &queueJSONAttributeHandler{
+		AttributeName: sqs.QueueAttributeNameRedriveAllowPolicy,
+		Description:   "Redrive Allow Policy",
+		SchemaKey:     "redrive_allow_policy",
+	}
+
+	return &schema.Resource{
+		Create: h.Upsert,
+		Read:   h.Read,
+		Update: h.Upsert,
+		Delete: h.Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"queue_url": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"redrive_allow_policy": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
@@ -67,6 +67,7 @@ import (
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
+	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kinesis"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalytics"
 	_ "github.com/hashicorp/terraform-provider-aws/internal/service/kinesisanalyticsv2"
@@ -3,6 +3,7 @@ package verify
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -66,6 +67,22 @@ func SuppressEquivalentTypeStringBoolean(k, old, new string, d *schema.ResourceD
 	return false
 }
 
+// SuppressEquivalentRFC3339Time suppresses differences between RFC3339 timestamps
+// that represent the same instant, e.g. in different time zones.
+func SuppressEquivalentRFC3339Time(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+
+	return oldTime.Equal(newTime)
+}
+
 // SuppressMissingOptionalConfigurationBlock handles configuration block attributes in the following scenario:
 //  * The resource schema includes an optional configuration block with defaults
 //  * The API response includes those defaults to refresh into the Terraform state
@@ -46,6 +46,52 @@ func TestSuppressEquivalentTypeStringBoolean(t *testing.T) {
 	}
 }
 
+func TestSuppressEquivalentRFC3339Time(t *testing.T) {
+	testCases := []struct {
+		old        string
+		new        string
+		equivalent bool
+	}{
+		{
+			old:        "2022-01-01T00:00:00Z",
+			new:        "2022-01-01T00:00:00Z",
+			equivalent: true,
+		},
+		{
+			old:        "2021-12-31T22:00:00Z",
+			new:        "2022-01-01T00:00:00+02:00",
+			equivalent: true,
+		},
+		{
+			old:        "2022-01-01T00:00:00Z",
+			new:        "2022-01-01T00:00:00+02:00",
+			equivalent: false,
+		},
+		{
+			old:        "",
+			new:        "2022-01-01T00:00:00Z",
+			equivalent: false,
+		},
+		{
+			old:        "2022-01-01T00:00:00Z",
+			new:        "not a timestamp",
+			equivalent: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		value := SuppressEquivalentRFC3339Time("test_property", tc.old, tc.new, nil)
+
+		if tc.equivalent && !value {
+			t.Fatalf("expected test case %d to be equivalent", i)
+		}
+
+		if !tc.equivalent && value {
+			t.Fatalf("expected test case %d to not be equivalent", i)
+		}
+	}
+}
+
 func TestDiffStringMaps(t *testing.T) {
 	cases := []struct {
 		Old, New                  map[string]interface{}